@@ -0,0 +1,8 @@
+package lexers
+
+import (
+	"github.com/alecthomas/chroma/v2"
+)
+
+// HTML lexer.
+var HTML = chroma.MustNewXMLLexer(embedded, "embedded/html.xml")
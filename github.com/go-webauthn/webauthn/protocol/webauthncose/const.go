@@ -0,0 +1,5 @@
+package webauthncose
+
+const (
+	keyCannotDisplay = "Cannot display key"
+)
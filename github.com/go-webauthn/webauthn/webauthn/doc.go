@@ -0,0 +1,3 @@
+// Package webauthn contains the API functionality of the library. After creating and configuring a webauthn object,
+// users can call the object to create and validate web authentication credentials.
+package webauthn
@@ -0,0 +1,242 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"snippetbox.org/pkg/models"
+)
+
+// How many users the admin user list shows per page.
+const usersPerPage = 20
+
+// Pager describes where a page sits in a paged list. Params holds the list's
+// other query parameters, such as search terms, so links to other pages keep
+// them.
+type Pager struct {
+	Page   int
+	Pages  int
+	Path   string
+	Params url.Values
+}
+
+func (p *Pager) HasPrev() bool { return p.Page > 1 }
+func (p *Pager) HasNext() bool { return p.Page < p.Pages }
+
+// Link returns the URL of the given page.
+func (p *Pager) Link(page int) string {
+	params := url.Values{}
+	for k, v := range p.Params {
+		params[k] = v
+	}
+	params.Set("page", strconv.Itoa(page))
+
+	return p.Path + "?" + params.Encode()
+}
+
+func (p *Pager) PrevLink() string { return p.Link(p.Page - 1) }
+func (p *Pager) NextLink() string { return p.Link(p.Page + 1) }
+
+// pageParam returns the page number requested in the query string, which
+// defaults to the first page.
+func pageParam(r *http.Request) int {
+	page, err := strconv.Atoi(r.URL.Query().Get("page"))
+	if err != nil || page < 1 {
+		return 1
+	}
+	return page
+}
+
+func newPager(r *http.Request, page, perPage, total int, params url.Values) *Pager {
+	return &Pager{
+		Page:   page,
+		Pages:  (total + perPage - 1) / perPage,
+		Path:   r.URL.Path,
+		Params: params,
+	}
+}
+
+func (app *App) AdminUsers(w http.ResponseWriter, r *http.Request) {
+	params := url.Values{"q": {r.URL.Query().Get("q")}}
+	page := pageParam(r)
+
+	users, total, err := app.Users.SearchUsers(r.Context(), params.Get("q"), (page-1)*usersPerPage, usersPerPage)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	app.RenderHTML(w, r, "admin.users.page.html", &HTMLData{
+		Pager: newPager(r, page, usersPerPage, total, params),
+		Users: users,
+	})
+}
+
+// targetUser loads the user named by the :id in the URL. It writes a 404 and
+// returns nil if there's no such user.
+func (app *App) targetUser(w http.ResponseWriter, r *http.Request) *models.User {
+	id, err := strconv.Atoi(r.URL.Query().Get(":id"))
+	if err != nil || id < 1 {
+		app.NotFound(w)
+		return nil
+	}
+
+	user, err := app.Users.GetUser(r.Context(), id)
+	if err != nil {
+		app.ServerError(w, err)
+		return nil
+	}
+	if user == nil {
+		app.NotFound(w)
+		return nil
+	}
+
+	return user
+}
+
+func (app *App) AdminShowUser(w http.ResponseWriter, r *http.Request) {
+	user := app.targetUser(w, r)
+	if user == nil {
+		return
+	}
+
+	snippets, err := app.Snippets.UserSnippets(r.Context(), user.ID)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	roles, err := app.Users.Roles(r.Context())
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	session := app.Sessions.Load(r)
+	flash, err := session.PopString(w, "flash")
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	app.RenderHTML(w, r, "admin.user.page.html", &HTMLData{
+		Flash:    flash,
+		Roles:    roles,
+		Snippets: snippets,
+		User:     user,
+	})
+}
+
+// roleChange is the audit target for a change of role, so that the log shows
+// who was given which role.
+func roleChange(email, from, to string) string {
+	return fmt.Sprintf("%s: %s -> %s", email, from, to)
+}
+
+// adminAction runs an action against the user named in the URL, audits it
+// with the target the action returns, then flashes the message it returns and
+// goes back to the user's page. Admins can't use these actions on their own
+// account, so they can't lock themselves out.
+func (app *App) adminAction(w http.ResponseWriter, r *http.Request, auditAction string, action func(*models.User) (msg, target string, err error)) {
+	err := r.ParseForm()
+	if err != nil {
+		app.ClientError(w, http.StatusBadRequest)
+		return
+	}
+
+	user := app.targetUser(w, r)
+	if user == nil {
+		return
+	}
+
+	session := app.Sessions.Load(r)
+	currentUserID, err := session.GetInt("currentUserID")
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	msg := app.T(r, "You can't do that to your own account.")
+	if user.ID != currentUserID {
+		var target string
+		msg, target, err = action(user)
+		if err == models.ErrUnknownRole {
+			app.ClientError(w, http.StatusBadRequest)
+			return
+		} else if err != nil {
+			app.ServerError(w, err)
+			return
+		}
+
+		err = app.AuditAs(r, currentUserID, auditAction, target)
+		if err != nil {
+			app.ServerError(w, err)
+			return
+		}
+	}
+
+	err = session.PutString(w, "flash", msg)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	http.Redirect(w, r, fmt.Sprintf("/admin/users/%d", user.ID), http.StatusSeeOther)
+}
+
+func (app *App) AdminSetRole(w http.ResponseWriter, r *http.Request) {
+	app.adminAction(w, r, models.AuditRoleChanged, func(user *models.User) (string, string, error) {
+		role := r.PostForm.Get("role")
+		err := app.Users.SetRole(r.Context(), user.ID, role)
+		return app.T(r, "%s is now a %s.", user.Name, role), roleChange(user.Email, user.Role, role), err
+	})
+}
+
+func (app *App) AdminDisableUser(w http.ResponseWriter, r *http.Request) {
+	app.adminAction(w, r, models.AuditUserDisabled, func(user *models.User) (string, string, error) {
+		err := app.Users.SetDisabled(r.Context(), user.ID, true)
+		return app.T(r, "%s has been disabled and logged out.", user.Name), user.Email, err
+	})
+}
+
+func (app *App) AdminEnableUser(w http.ResponseWriter, r *http.Request) {
+	app.adminAction(w, r, models.AuditUserEnabled, func(user *models.User) (string, string, error) {
+		err := app.Users.SetDisabled(r.Context(), user.ID, false)
+		return app.T(r, "%s has been enabled.", user.Name), user.Email, err
+	})
+}
+
+func (app *App) AdminLogoutUser(w http.ResponseWriter, r *http.Request) {
+	app.adminAction(w, r, models.AuditUserLoggedOut, func(user *models.User) (string, string, error) {
+		err := app.Users.LogoutEverywhere(r.Context(), user.ID)
+		return app.T(r, "%s has been logged out everywhere.", user.Name), user.Email, err
+	})
+}
+
+// AdminResetPassword clears the user's password, which also logs them out, and
+// emails them a link to choose a new one.
+func (app *App) AdminResetPassword(w http.ResponseWriter, r *http.Request) {
+	app.adminAction(w, r, models.AuditForcedReset, func(user *models.User) (string, string, error) {
+		err := app.Users.ClearPassword(r.Context(), user.ID)
+		if err != nil {
+			return "", "", err
+		}
+
+		_, token, err := app.Users.InsertPasswordReset(r.Context(), user.Email, resetTokenLifetime)
+		if err != nil {
+			return "", "", err
+		}
+
+		body := fmt.Sprintf("Hi %s,\n\nAn administrator has reset the password for your Snippetbox account. "+
+			"Follow the link below within %v to choose a new one:\n\n%s\n", user.Name, resetTokenLifetime,
+			app.resetLink(token))
+		err = app.Mailer.Send(user.Email, "Your Snippetbox password has been reset", body)
+		if err != nil {
+			return "", "", err
+		}
+
+		return app.T(r, "%s's password has been reset and a reset link emailed to them.", user.Name), user.Email, nil
+	})
+}
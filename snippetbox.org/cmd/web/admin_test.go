@@ -0,0 +1,52 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"snippetbox.org/pkg/models"
+)
+
+func TestAdminSetRoleAudit(t *testing.T) {
+	ctx := context.Background()
+	app, db := newTestApp(t)
+
+	for _, email := range []string{"admin@example.com", "alice@example.com"} {
+		err := db.InsertUser(ctx, "User", email, "correct horse")
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	admin, err := db.GetUser(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := newTestClient(app)
+	err = client.logIn(admin)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	form := url.Values{"role": {models.RoleAdmin}}
+	r := httptest.NewRequest(http.MethodPost, "/admin/users/2/role?:id=2", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rr := client.do(app.AdminSetRole, r)
+	if rr.Code != http.StatusSeeOther {
+		t.Fatalf("got status %d; want 303", rr.Code)
+	}
+
+	// The log says who was given which role, and by whom.
+	entries, _, err := db.AuditEntries(ctx, &models.AuditFilter{Action: models.AuditRoleChanged}, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := roleChange("alice@example.com", models.DefaultRole, models.RoleAdmin)
+	if len(entries) != 1 || entries[0].Target != want || entries[0].ActorID != admin.ID {
+		t.Errorf("got role change audit entries %+v; want one by %d for %q", entries, admin.ID, want)
+	}
+}
@@ -1,18 +1,54 @@
 package main
 
 import (
+	"context"
+	"io/fs"
+	"log/slog"
+	"time"
+
+	"snippetbox.org/pkg/health"
+	"snippetbox.org/pkg/i18n"
+	"snippetbox.org/pkg/mailer"
 	"snippetbox.org/pkg/models"
+	"snippetbox.org/pkg/ratelimit"
+	"snippetbox.org/pkg/throttle"
 
 	"github.com/alexedwards/scs"
+	"github.com/go-webauthn/webauthn/webauthn"
 )
 
 type App struct {
+	ACME      *ACMEConfig
+	AccountThrottle *throttle.Throttle
 	Addr      string
-	Database *models.Database
-	HTMLDir   string
+	AuthLimit *ratelimit.Limiter
+	Background func(fn func(ctx context.Context))
+	BaseURL   string
+	GlobalLimit *ratelimit.Limiter
+	Health    *health.Checker
+	HSTS      string
+	HTTPAddr  string
+	IPThrottle *throttle.Throttle
+	Locales   *i18n.Bundle
+	LockoutMailLimit *ratelimit.Limiter
+	Logger    *slog.Logger
+	Mailer    mailer.Mailer
+	Metrics   *Metrics
+	MetricsAddr string
+	MetricsToken string
+	OIDC      *OIDC
+	ResetMailLimit *ratelimit.Limiter
 	Sessions *scs.Manager
-	Admin    *scs.Manager
-	StaticDir string
+	ShutdownTimeout time.Duration
+	Snippets  models.SnippetStore
+	Static    fs.FS
 	TLSCert   string
 	TLSKey    string
+	Templates *Templates
+	TLSCipherSuites []uint16
+	TLSMinVersion uint16
+	TrashDays int
+	Users     models.UserStore
+	WebAuthn  *webauthn.WebAuthn
+	WriteLimit *ratelimit.Limiter
 }
\ No newline at end of file
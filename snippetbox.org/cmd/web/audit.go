@@ -0,0 +1,133 @@
+package main
+
+import (
+	"encoding/csv"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"snippetbox.org/pkg/models"
+)
+
+// How many entries the audit log viewer shows per page.
+const auditPerPage = 50
+
+// The longest target and user agent the audit_log table holds, in characters.
+const auditFieldLength = 255
+
+// Audit records an action by the logged in user, if there is one, against a
+// target such as an email address or snippet.
+func (app *App) Audit(r *http.Request, action, target string) error {
+	actorID, err := app.Sessions.Load(r).GetInt("currentUserID")
+	if err != nil {
+		return err
+	}
+
+	return app.AuditAs(r, actorID, action, target)
+}
+
+// AuditAs records an action by the given user. An actor ID of 0 means the
+// action wasn't taken by a known user, such as a failed login.
+func (app *App) AuditAs(r *http.Request, actorID int, action, target string) error {
+	return app.Users.InsertAudit(r.Context(), &models.AuditEntry{
+		Action:    action,
+		ActorID:   actorID,
+		Target:    truncate(target, auditFieldLength),
+		IP:        clientIP(r),
+		UserAgent: truncate(r.UserAgent(), auditFieldLength),
+	})
+}
+
+// truncate shortens s to at most n characters.
+func truncate(s string, n int) string {
+	i := 0
+	for j := range s {
+		if i == n {
+			return s[:j]
+		}
+		i++
+	}
+	return s
+}
+
+// auditFilter reads the audit log filter from the query string. Dates are
+// whole days in UTC, and the "to" day is included.
+func auditFilter(r *http.Request) (*models.AuditFilter, url.Values) {
+	q := r.URL.Query()
+	params := url.Values{}
+	for _, key := range []string{"action", "actor", "target", "from", "to"} {
+		params.Set(key, q.Get(key))
+	}
+
+	f := &models.AuditFilter{
+		Action: params.Get("action"),
+		Actor:  params.Get("actor"),
+		Target: params.Get("target"),
+	}
+	if t, err := time.Parse("2006-01-02", params.Get("from")); err == nil {
+		f.From = t
+	}
+	if t, err := time.Parse("2006-01-02", params.Get("to")); err == nil {
+		f.To = t.AddDate(0, 0, 1)
+	}
+
+	return f, params
+}
+
+func (app *App) AuditLog(w http.ResponseWriter, r *http.Request) {
+	filter, params := auditFilter(r)
+	page := pageParam(r)
+
+	entries, total, err := app.Users.AuditEntries(r.Context(), filter, (page-1)*auditPerPage, auditPerPage)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	app.RenderHTML(w, r, "admin.audit.page.html", &HTMLData{
+		AuditActions: models.AuditActions,
+		AuditEntries: entries,
+		Pager:        newPager(r, page, auditPerPage, total, params),
+	})
+}
+
+// ExportAuditLog sends every entry matching the filter as a CSV file.
+func (app *App) ExportAuditLog(w http.ResponseWriter, r *http.Request) {
+	filter, _ := auditFilter(r)
+
+	entries, _, err := app.Users.AuditEntries(r.Context(), filter, 0, 0)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", `attachment; filename="audit.csv"`)
+
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"id", "time", "action", "actor_id", "actor", "target", "ip", "user_agent"})
+	for _, e := range entries {
+		cw.Write([]string{
+			strconv.Itoa(e.ID),
+			e.Created.UTC().Format(time.RFC3339),
+			csvText(e.Action),
+			strconv.Itoa(e.ActorID),
+			csvText(e.ActorName),
+			csvText(e.Target),
+			csvText(e.IP),
+			csvText(e.UserAgent),
+		})
+	}
+	cw.Flush()
+}
+
+// csvText stops a spreadsheet treating a cell as a formula by starting it with
+// a quote when it begins with a character that would.
+func csvText(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
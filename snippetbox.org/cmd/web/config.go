@@ -0,0 +1,157 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Settings that can also be read from a file named by a matching -file setting,
+// such as -secret-file, so they needn't appear in the config file, the
+// environment or the process list.
+var secretSettings = []string{"dsn", "secret", "smtp-pass", "oidc-client-secret", "metrics-token"}
+
+// The session secret that used to be built in as the -secret default. It's in
+// the repository history, so it mustn't be used.
+const leakedSecret = "sb04y4ER5irMeOppyf5qdJG9kQSjWw2F"
+
+// envName returns the environment variable for a setting, such as
+// SNIPPETBOX_SMTP_ADDR for smtp-addr.
+func envName(setting string) string {
+	return "SNIPPETBOX_" + strings.ToUpper(strings.ReplaceAll(setting, "-", "_"))
+}
+
+// loadConfig parses the settings defined as flags in fs in layers, each
+// overriding the last: the flag defaults, then a YAML config file, then
+// SNIPPETBOX_* environment variables, then the command line. The config file
+// is named by -config or SNIPPETBOX_CONFIG, and maps setting names to values:
+//
+//	addr: ":443"
+//	smtp-addr: smtp.example.com:587
+//	secret-file: /run/secrets/session
+//
+// Settings in the file that fs doesn't define are an error, unless partial is
+// set for a command that only needs some of the server's settings.
+func loadConfig(fs *flag.FlagSet, args []string, partial bool) error {
+	configPath := fs.String("config", "", "Path to a YAML config file")
+	for _, name := range secretSettings {
+		fs.String(name+"-file", "", fmt.Sprintf("Path to a file containing -%s", name))
+	}
+
+	err := fs.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	onCommandLine := make(map[string]bool)
+	fs.Visit(func(f *flag.Flag) {
+		onCommandLine[f.Name] = true
+	})
+
+	path := *configPath
+	if path == "" {
+		path = os.Getenv(envName("config"))
+	}
+
+	settings := make(map[string]string)
+	if path != "" {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		values := make(map[string]interface{})
+		err = yaml.Unmarshal(b, &values)
+		if err != nil {
+			return fmt.Errorf("config: %s: %w", path, err)
+		}
+
+		for name, value := range values {
+			switch value.(type) {
+			case map[string]interface{}, []interface{}:
+				return fmt.Errorf("config: %s: %s must be a single value", path, name)
+			}
+			settings[name] = fmt.Sprint(value)
+		}
+	}
+
+	fs.VisitAll(func(f *flag.Flag) {
+		if value, ok := os.LookupEnv(envName(f.Name)); ok {
+			settings[f.Name] = value
+		}
+	})
+
+	for name, value := range settings {
+		if fs.Lookup(name) == nil {
+			if partial {
+				continue
+			}
+			return fmt.Errorf("config: unknown setting %q", name)
+		}
+		if onCommandLine[name] {
+			continue
+		}
+
+		err := fs.Set(name, value)
+		if err != nil {
+			return fmt.Errorf("config: %s: %w", name, err)
+		}
+	}
+
+	for _, name := range secretSettings {
+		path := fs.Lookup(name + "-file").Value.String()
+		if path == "" {
+			continue
+		}
+
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		err = fs.Set(name, strings.TrimRight(string(b), "\r\n"))
+		if err != nil {
+			return fmt.Errorf("config: %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// validateSecret checks the session secret is usable as a cookie store key,
+// which must be exactly 32 bytes.
+func validateSecret(secret string) error {
+	switch {
+	case secret == "":
+		return errors.New("config: a session secret is required (set -secret, SNIPPETBOX_SECRET or -secret-file)")
+	case secret == leakedSecret:
+		return errors.New("config: the session secret is the old built-in default; generate a new one")
+	case len(secret) != 32:
+		return fmt.Errorf("config: the session secret must be exactly 32 bytes, not %d", len(secret))
+	}
+
+	return nil
+}
+
+// parseBaseURL checks the site's public URL, which links in emails are built
+// from rather than the request's Host header that anyone can set, and returns
+// it without a trailing slash.
+func parseBaseURL(s string) (string, error) {
+	u, err := url.Parse(s)
+	if err != nil {
+		return "", fmt.Errorf("config: base URL: %w", err)
+	}
+	if (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return "", fmt.Errorf("config: base URL %q must be an absolute http or https URL", s)
+	}
+	if u.User != nil || u.RawQuery != "" || u.Fragment != "" {
+		return "", fmt.Errorf("config: base URL %q can't have a user, query or fragment", s)
+	}
+
+	return strings.TrimRight(u.String(), "/"), nil
+}
@@ -0,0 +1,174 @@
+package main
+
+import (
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoadConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		env     map[string]string
+		args    []string
+		partial bool
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name: "Defaults",
+			want: map[string]string{"addr": ":4000", "smtp-addr": ""},
+		},
+		{
+			name: "File over defaults",
+			file: "addr: \":443\"\nsmtp-addr: smtp.example.com:587\n",
+			want: map[string]string{"addr": ":443", "smtp-addr": "smtp.example.com:587"},
+		},
+		{
+			name: "Environment over file",
+			file: "addr: \":443\"\nsmtp-addr: smtp.example.com:587\n",
+			env:  map[string]string{"SNIPPETBOX_ADDR": ":8443"},
+			want: map[string]string{"addr": ":8443", "smtp-addr": "smtp.example.com:587"},
+		},
+		{
+			name: "Flags over environment",
+			file: "addr: \":443\"\n",
+			env:  map[string]string{"SNIPPETBOX_ADDR": ":8443"},
+			args: []string{"-addr", ":9443"},
+			want: map[string]string{"addr": ":9443"},
+		},
+		{
+			name: "Config file from the environment",
+			file: "addr: \":443\"\n",
+			env:  map[string]string{"SNIPPETBOX_CONFIG": "{config}"},
+			want: map[string]string{"addr": ":443"},
+		},
+		{
+			name: "Secret file",
+			args: []string{"-secret-file", "{secret}"},
+			want: map[string]string{"secret": strings.Repeat("s", 32)},
+		},
+		{
+			name: "Secret file from the config file",
+			file: "secret-file: \"{secret}\"\n",
+			want: map[string]string{"secret": strings.Repeat("s", 32)},
+		},
+		{
+			name:    "Unknown setting",
+			file:    "addr: \":443\"\ntrash-days: 7\n",
+			wantErr: true,
+		},
+		{
+			name:    "Unknown setting in a partial config",
+			file:    "addr: \":443\"\ntrash-days: 7\n",
+			partial: true,
+			want:    map[string]string{"addr": ":443"},
+		},
+		{
+			name:    "Nested setting",
+			file:    "addr:\n  port: 443\n",
+			wantErr: true,
+		},
+		{
+			name:    "Missing secret file",
+			args:    []string{"-secret-file", "/nonexistent/secret"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			secretPath := filepath.Join(dir, "secret")
+			err := os.WriteFile(secretPath, []byte(strings.Repeat("s", 32)+"\n"), 0600)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			configPath := filepath.Join(dir, "config.yml")
+			replacer := strings.NewReplacer("{secret}", secretPath, "{config}", configPath)
+
+			args := []string{}
+			if tt.file != "" {
+				err = os.WriteFile(configPath, []byte(replacer.Replace(tt.file)), 0600)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if tt.env["SNIPPETBOX_CONFIG"] == "" {
+					args = append(args, "-config", configPath)
+				}
+			}
+			for _, arg := range tt.args {
+				args = append(args, replacer.Replace(arg))
+			}
+			for name, value := range tt.env {
+				t.Setenv(name, replacer.Replace(value))
+			}
+
+			fs := flag.NewFlagSet("test", flag.ContinueOnError)
+			fs.SetOutput(io.Discard)
+			fs.String("addr", ":4000", "")
+			fs.String("smtp-addr", "", "")
+			fs.String("secret", "", "")
+
+			err = loadConfig(fs, args, tt.partial)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v; want error %v", err, tt.wantErr)
+			}
+
+			for name, want := range tt.want {
+				if got := fs.Lookup(name).Value.String(); got != want {
+					t.Errorf("got %s %q; want %q", name, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateSecret(t *testing.T) {
+	tests := []struct {
+		secret  string
+		wantErr bool
+	}{
+		{strings.Repeat("k", 32), false},
+		{"", true},
+		{leakedSecret, true},
+		{strings.Repeat("k", 31), true},
+		{strings.Repeat("k", 33), true},
+	}
+
+	for _, tt := range tests {
+		err := validateSecret(tt.secret)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("validateSecret(%q) = %v; want error %v", tt.secret, err, tt.wantErr)
+		}
+	}
+}
+
+func TestParseBaseURL(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{"https://snippetbox.org", "https://snippetbox.org", false},
+		{"https://snippetbox.org/", "https://snippetbox.org", false},
+		{"http://localhost:4000/snippets/", "http://localhost:4000/snippets", false},
+		{"", "", true},
+		{"snippetbox.org", "", true},
+		{"ftp://snippetbox.org", "", true},
+		{"https://user@snippetbox.org", "", true},
+		{"https://snippetbox.org/?next=/", "", true},
+	}
+
+	for _, tt := range tests {
+		got, err := parseBaseURL(tt.in)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("parseBaseURL(%q) = %q, %v; want %q, error %v", tt.in, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
@@ -1,15 +1,32 @@
 package main
 
 import (
-	"log"
+	"errors"
 	"net/http"
 	"runtime/debug"
+
+	"snippetbox.org/pkg/models"
 )
 
-// The ServerError helper writes an error message and stack trace to the log, then
-// sends a generic 500 Internal Server Error response to the user.
+// The ServerError helper writes an error message and stack trace to the log, tagged
+// with the request ID so it can be matched to the access log entry, then sends a
+// generic 500 Internal Server Error response to the user. A database call that
+// timed out gets a 504 Gateway Timeout instead, and one that was canceled, usually
+// because the client went away or the server is shutting down, a 503 Service
+// Unavailable. Neither is a bug, so they're logged without the stack trace.
 func (app *App) ServerError(w http.ResponseWriter, err error) {
-	log.Printf("%s\n%s", err.Error(), debug.Stack())
+	switch {
+	case errors.Is(err, models.ErrTimeout):
+		app.Logger.Warn(err.Error(), "request_id", requestID(w))
+		app.ClientError(w, http.StatusGatewayTimeout)
+		return
+	case errors.Is(err, models.ErrCanceled):
+		app.Logger.Warn(err.Error(), "request_id", requestID(w))
+		app.ClientError(w, http.StatusServiceUnavailable)
+		return
+	}
+
+	app.Logger.Error(err.Error(), "request_id", requestID(w), "stack", string(debug.Stack()))
 	http.Error(w, "Internal Server Error", http.StatusInternalServerError)
 }
 
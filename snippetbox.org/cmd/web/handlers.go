@@ -1,15 +1,27 @@
 package main
 
 import (
+	"html/template"
 	"net/http"
 	"strconv"
 	"snippetbox.org/pkg/forms"
+	"snippetbox.org/pkg/markdown"
 	"snippetbox.org/pkg/models"
+	"context"
 	"fmt"
+	"strings"
+	"time"
 )
 
+// Password reset links stop working after this long.
+const resetTokenLifetime = time.Hour
+
+func (app *App) resetLink(token string) string {
+	return app.BaseURL + "/user/password/reset/" + token
+}
+
 func (app *App) Home(w http.ResponseWriter, r *http.Request) {
-	snippets, err := app.Database.LatestSnippets()
+	snippets, err := app.Snippets.LatestSnippets(r.Context())
 	if err != nil {
 		app.ServerError(w, err)
 		return
@@ -27,7 +39,7 @@ func (app *App) ShowSnippet(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	snippet, err := app.Database.GetSnippet(id)
+	snippet, err := app.Snippets.GetSnippet(r.Context(), id)
 	if err != nil {
 		app.ServerError(w, err)
 		return
@@ -45,12 +57,42 @@ func (app *App) ShowSnippet(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	user, err := app.CurrentUser(r)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	var rendered template.HTML
+	if snippet.ContentType == models.ContentMarkdown {
+		rendered, err = markdown.Render(snippet.Content)
+		if err != nil {
+			app.ServerError(w, err)
+			return
+		}
+	}
+
 	app.RenderHTML(w, r, "show.page.html", &HTMLData{
 	Flash:   flash,
 	Snippet: snippet,
+	SnippetHTML: rendered,
+	User:    user,
 	})
 }
 
+// HighlightCSS serves the stylesheet for code highlighted in markdown
+// snippets.
+func (app *App) HighlightCSS(w http.ResponseWriter, r *http.Request) {
+	css, err := markdown.CSS()
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/css; charset=utf-8")
+	w.Write(css)
+}
+
 func (app *App) NewSnippet(w http.ResponseWriter, r *http.Request) {
 	app.RenderHTML(w, r, "new.page.html", &HTMLData{
 		Form: &forms.NewSnippet{},
@@ -67,6 +109,7 @@ func (app *App) CreateSnippet(w http.ResponseWriter, r *http.Request) {
 	form := &forms.NewSnippet{
 		Title: r.PostForm.Get("title"),
 		Content: r.PostForm.Get("content"),
+		ContentType: r.PostForm.Get("content_type"),
 		Expires: r.PostForm.Get("expires"),
 	}
 
@@ -77,18 +120,26 @@ func (app *App) CreateSnippet(w http.ResponseWriter, r *http.Request) {
 
 	session := app.Sessions.Load(r)
 
-	err = session.PutString(w, "flash", "Your snippet was saved successfully!")
+	err = session.PutString(w, "flash", app.T(r, "Your snippet was saved successfully!"))
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	userID, err := session.GetInt("currentUserID")
 	if err != nil {
 		app.ServerError(w, err)
 		return
 	}
 
-	id, err := app.Database.InsertSnippet(form.Title, form.Content, form.Expires)
+	id, err := app.Snippets.InsertSnippet(r.Context(), userID, form.Title, form.Content, form.ContentType, form.Expires)
 	if err != nil {
 		app.ServerError(w, err)
 		return
 	}
 
+	app.Metrics.SnippetsCreated.Inc()
+
 	http.Redirect(w, r, fmt.Sprintf("/snippet/%d", id), http.StatusSeeOther)
 }
 
@@ -114,13 +165,54 @@ func (app *App) DeleteSnippet(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = app.Database.DeleteSnippet(form.Id)
+	id, _ := strconv.Atoi(form.Id)
+	snippet, err := app.Snippets.GetSnippet(r.Context(), id)
 	if err != nil {
 		app.ServerError(w, err)
 		return
 	}
+	if snippet == nil {
+		form.Failures["Id"] = "No snippet with that Id"
+		app.RenderHTML(w, r, "delete.page.html", &HTMLData{Form: form})
+		return
+	}
 
-	http.Redirect(w, r, fmt.Sprintf("/"), http.StatusSeeOther)
+	// Authors can delete their own snippets, moderators can delete anyone's.
+	user, err := app.CurrentUser(r)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+	canDelete, err := app.Can(r, models.PermDeleteSnippets)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+	if !canDelete && snippet.UserID != user.ID {
+		app.ClientError(w, http.StatusForbidden)
+		return
+	}
+
+	err = app.Snippets.DeleteSnippet(r.Context(), id, user.ID)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	err = app.Audit(r, models.AuditSnippetDeleted, "snippet "+form.Id)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	session := app.Sessions.Load(r)
+	err = session.PutString(w, "flash", app.T(r, "Snippet #%d was moved to the trash.", id))
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	http.Redirect(w, r, "/snippet/trash", http.StatusSeeOther)
 }
 
 func (app *App) SignupUser(w http.ResponseWriter, r *http.Request) {
@@ -146,7 +238,7 @@ func (app *App) CreateUser(w http.ResponseWriter, r *http.Request) {
 	}
 	// Try to create a new user record in the database. If the email already exists
 	// add a failure message to the form and re-display the form.
-	err = app.Database.InsertUser(form.Name, form.Email, form.Password)
+	err = app.Users.InsertUser(r.Context(), form.Name, form.Email, form.Password)
 	if err == models.ErrDuplicateEmail {
 		form.Failures["Email"] = "Address is already in use"
 		app.RenderHTML(w, r, "signup.page.html", &HTMLData{Form: form})
@@ -155,9 +247,17 @@ func (app *App) CreateUser(w http.ResponseWriter, r *http.Request) {
 		app.ServerError(w, err)
 		return
 	}
+
+	app.Metrics.Signups.Inc()
+
+	err = app.AuditAs(r, 0, models.AuditSignup, form.Email)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
 	// Otherwise, add a confirmation flash message to the session confirming that
 	// their signup worked and asking them to log in.
-	msg := "Your signup was successful. Please log in using your credentials."
+	msg := app.T(r, "Your signup was successful. Please log in using your credentials.")
 	session := app.Sessions.Load(r)
 	err = session.PutString(w, "flash", msg)
 	if err != nil {
@@ -200,10 +300,37 @@ func (app *App) VerifyUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Don't check the password at all while the account or the client's IP is
+	// backing off after failed attempts, or is locked out.
+	wait, err := app.loginWait(r, form.Email)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+	if wait > 0 {
+		form.Failures["Generic"] = app.T(r, "Too many failed attempts. Please try again in %s.", app.Locale(r).Duration(wait))
+		app.RenderHTML(w, r, "login.page.html", &HTMLData{Form: form})
+		return
+	}
+
 	// Check whether the credentials are valid. If they're not, add a generic error
 	// message to the form failures map, and re-display the login page.
-	currentUserID, err, admin := app.Database.VerifyUser(form.Email, form.Password)
+	currentUserID, err := app.Users.VerifyUser(r.Context(), form.Email, form.Password)
 	if err == models.ErrInvalidCredentials {
+		err = app.loginFailed(r, form.Email)
+		if err != nil {
+			app.ServerError(w, err)
+			return
+		}
+
+		app.Metrics.LoginsFailed.Inc()
+
+		err = app.AuditAs(r, 0, models.AuditLoginFailed, form.Email)
+		if err != nil {
+			app.ServerError(w, err)
+			return
+		}
+
 		form.Failures["Generic"] = "Email or Password is incorrect"
 		app.RenderHTML(w, r, "login.page.html", &HTMLData{Form: form})
 		return
@@ -212,56 +339,35 @@ func (app *App) VerifyUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if admin == false {
-		// Add the ID of the current user to the session, so that they are now 'logged
-		// in'.
-		session := app.Sessions.Load(r)
-		err = session.PutInt(w, "currentUserID", currentUserID)
-		if err != nil {
-			app.ServerError(w, err)
-			return
-		}
-
-		// Redirect the user to the Add Snippet page.
-		http.Redirect(w, r, "/snippet/new", http.StatusSeeOther)
+	err = app.AccountThrottle.Reset(r.Context(), accountKey(form.Email))
+	if err != nil {
+		app.ServerError(w, err)
+		return
 	}
 
-	// Add the ID of the current admin user to the session, so that they are now 'logged
-	// in'.
-	session := app.Admin.Load(r)
-	err = session.PutInt(w, "currentAdminID", currentUserID)
+	user, err := app.Users.GetUser(r.Context(), currentUserID)
 	if err != nil {
 		app.ServerError(w, err)
 		return
 	}
 
-	if err == nil {
-		// Add the ID of the current user to the session, so that they are now 'logged
-		// in'.
-		session := app.Sessions.Load(r)
-		err = session.PutInt(w, "currentUserID", currentUserID)
-		if err != nil {
-			app.ServerError(w, err)
-			return
-		}
+	// If the user has two-factor authentication turned on they're only half logged
+	// in at this point, and need to enter a code before they're treated as
+	// logged in.
+	if user.TOTPEnabled {
+		app.StartTwoFactor(w, r, user)
+		return
 	}
 
-	// Redirect the user to the Add Snippet page.
-	http.Redirect(w, r, "/", http.StatusSeeOther)
+	app.CompleteLogin(w, r, user)
 }
 
 func (app *App) LogoutUser(w http.ResponseWriter, r *http.Request) {
 	// Remove the currentUserID from the session data.
 	session := app.Sessions.Load(r)
 
-	err := session.Remove(w, "currentAdminID")
-	if err != nil {
-		app.ServerError(w, err)
-		return
-	}
-
-	if err == nil {
-		err := session.Remove(w, "currentUserID")
+	for _, key := range []string{"currentUserID", "sessionVersion"} {
+		err := session.Remove(w, key)
 		if err != nil {
 			app.ServerError(w, err)
 			return
@@ -294,7 +400,7 @@ func (app *App) CreateAdmin(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = app.Database.InsertAdmin(form.Name, form.Email, form.Password)
+	err = app.Users.InsertAdmin(r.Context(), form.Name, form.Email, form.Password)
 	if err == models.ErrDuplicateEmail {
 		form.Failures["Email"] = "Address is already in use"
 		app.RenderHTML(w, r, "signup.admin.page.html", &HTMLData{Form: form})
@@ -304,7 +410,13 @@ func (app *App) CreateAdmin(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	msg := "Your Admin signup was successful. Please log in using your credentials."
+	err = app.Audit(r, models.AuditAdminCreated, form.Email)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	msg := app.T(r, "Your Admin signup was successful. Please log in using your credentials.")
 	session := app.Sessions.Load(r)
 	err = session.PutString(w, "flash", msg)
 	if err != nil {
@@ -313,4 +425,147 @@ func (app *App) CreateAdmin(w http.ResponseWriter, r *http.Request) {
 	}
 
 	http.Redirect(w, r, "/", http.StatusSeeOther)
+}
+
+func (app *App) ForgotPassword(w http.ResponseWriter, r *http.Request) {
+	app.RenderHTML(w, r, "password.forgot.page.html", &HTMLData{
+		Form: &forms.ForgotPassword{},
+	})
+}
+
+func (app *App) SendPasswordReset(w http.ResponseWriter, r *http.Request) {
+	err := r.ParseForm()
+	if err != nil {
+		app.ClientError(w, http.StatusBadRequest)
+		return
+	}
+
+	form := &forms.ForgotPassword{
+		Email: r.PostForm.Get("email"),
+	}
+
+	if !form.Valid() {
+		app.RenderHTML(w, r, "password.forgot.page.html", &HTMLData{Form: form})
+		return
+	}
+
+	// Only send an email if the address belongs to an account, but show the same
+	// message either way so the form can't be used to find out who has signed up.
+	// So that it can't be used to flood someone's inbox either, only a few
+	// emails are sent to each address, whether or not it has an account.
+	ok, _, err := app.ResetMailLimit.Allow(r.Context(), strings.ToLower(form.Email))
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	if ok {
+		user, token, err := app.Users.InsertPasswordReset(r.Context(), form.Email, resetTokenLifetime)
+		if err != nil {
+			app.ServerError(w, err)
+			return
+		}
+
+		// The email is sent in the background, and a failure only logged, so
+		// that neither the response nor how long it takes gives the account
+		// away.
+		if user != nil {
+			body := fmt.Sprintf("Hi %s,\n\nSomeone asked to reset the password for your Snippetbox account. "+
+				"If it was you, follow the link below within %v to choose a new password:\n\n%s\n\n"+
+				"If it wasn't you, you can ignore this email.\n", user.Name, resetTokenLifetime, app.resetLink(token))
+
+			app.Background(func(ctx context.Context) {
+				err := app.Mailer.Send(user.Email, "Reset your Snippetbox password", body)
+				if err != nil {
+					app.Logger.Error("sending password reset email", "error", err.Error())
+				}
+			})
+		}
+	}
+
+	msg := app.T(r, "If that address has an account, we've emailed it a link to reset the password.")
+	session := app.Sessions.Load(r)
+	err = session.PutString(w, "flash", msg)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	http.Redirect(w, r, "/user/login", http.StatusSeeOther)
+}
+
+func (app *App) ResetPassword(w http.ResponseWriter, r *http.Request) {
+	token := r.URL.Query().Get(":token")
+
+	valid, err := app.Users.PasswordResetValid(r.Context(), token)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	form := &forms.ResetPassword{Token: token}
+	if !valid {
+		form.Failures = map[string]string{"Generic": "This reset link is invalid or has expired"}
+	}
+
+	app.RenderHTML(w, r, "password.reset.page.html", &HTMLData{Form: form})
+}
+
+func (app *App) UpdatePassword(w http.ResponseWriter, r *http.Request) {
+	err := r.ParseForm()
+	if err != nil {
+		app.ClientError(w, http.StatusBadRequest)
+		return
+	}
+
+	form := &forms.ResetPassword{
+		Token:    r.URL.Query().Get(":token"),
+		Password: r.PostForm.Get("password"),
+		Confirm:  r.PostForm.Get("confirm"),
+	}
+
+	if !form.Valid() {
+		app.RenderHTML(w, r, "password.reset.page.html", &HTMLData{Form: form})
+		return
+	}
+
+	userID, err := app.Users.ResetPassword(r.Context(), form.Token, form.Password)
+	if err == models.ErrInvalidResetToken {
+		form.Failures["Generic"] = "This reset link is invalid or has expired"
+		app.RenderHTML(w, r, "password.reset.page.html", &HTMLData{Form: form})
+		return
+	} else if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	user, err := app.Users.GetUser(r.Context(), userID)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	err = app.AuditAs(r, user.ID, models.AuditPasswordReset, user.Email)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	body := fmt.Sprintf("Hi %s,\n\nThe password for your Snippetbox account was just changed and "+
+		"all existing sessions have been logged out.\n", user.Name)
+	err = app.Mailer.Send(user.Email, "Your Snippetbox password was changed", body)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	msg := app.T(r, "Your password has been reset. Please log in using your new credentials.")
+	session := app.Sessions.Load(r)
+	err = session.PutString(w, "flash", msg)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	http.Redirect(w, r, "/user/login", http.StatusSeeOther)
 }
\ No newline at end of file
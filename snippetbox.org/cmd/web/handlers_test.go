@@ -0,0 +1,66 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+// brokenMailer fails to send anything.
+type brokenMailer struct{}
+
+func (brokenMailer) Send(to, subject, body string) error {
+	return errors.New("SMTP server is down")
+}
+
+func TestSendPasswordReset(t *testing.T) {
+	tests := []struct {
+		name     string
+		email    string
+		requests int
+		broken   bool
+		wantSent int
+	}{
+		{"Account", "alice@example.com", 1, false, 1},
+		{"No account", "bob@example.com", 1, false, 0},
+		{"Too many", "alice@example.com", 5, false, 3},
+		{"Mail server down", "alice@example.com", 1, true, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app, db := newTestApp(t)
+			mail := &testMailer{}
+			app.Mailer = mail
+			if tt.broken {
+				app.Mailer = brokenMailer{}
+			}
+
+			err := db.InsertUser(context.Background(), "Alice", "alice@example.com", "correct horse")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// Every request gets the same response, whether an email was sent
+			// or not.
+			for i := 0; i < tt.requests; i++ {
+				form := url.Values{"email": {tt.email}}
+				r := httptest.NewRequest(http.MethodPost, "/user/password/forgot", strings.NewReader(form.Encode()))
+				r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+				rr := newTestClient(app).do(app.SendPasswordReset, r)
+				if rr.Code != http.StatusSeeOther || rr.Header().Get("Location") != "/user/login" {
+					t.Fatalf("got status %d to %q; want 303 to /user/login", rr.Code, rr.Header().Get("Location"))
+				}
+			}
+
+			if len(mail.sent) != tt.wantSent {
+				t.Errorf("sent %d emails; want %d", len(mail.sent), tt.wantSent)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"snippetbox.org/pkg/health"
+)
+
+// Healthz reports that the process is up and serving requests. It doesn't
+// check any dependencies, so a database outage won't get the process restarted.
+func (app *App) Healthz(w http.ResponseWriter, r *http.Request) {
+	app.RenderJSON(w, http.StatusOK, map[string]string{"status": health.StatusOK})
+}
+
+// Readyz runs every registered health check, and responds with 503 Service
+// Unavailable if any of them fail so that traffic is routed elsewhere.
+func (app *App) Readyz(w http.ResponseWriter, r *http.Request) {
+	report := app.Health.Run(r.Context())
+
+	status := http.StatusOK
+	if report.Status != health.StatusOK {
+		status = http.StatusServiceUnavailable
+	}
+
+	app.RenderJSON(w, status, report)
+}
+
+// RegisterHealthChecks registers the checks for the application's own
+// dependencies: the database, the templates and the session store. The
+// database check is named after the kind of database, such as "mysql".
+func (app *App) RegisterHealthChecks(dbName string, db *sql.DB) {
+	app.Health.Register(dbName, db.PingContext)
+	app.Health.Register("templates", app.checkTemplates)
+	app.Health.Register("sessions", app.checkSessions)
+}
+
+// checkTemplates checks that the templates parsed the last time they were
+// loaded. That only changes when they're reloaded in development.
+func (app *App) checkTemplates(ctx context.Context) error {
+	return app.Templates.Err()
+}
+
+// checkSessions saves a value to a new session and loads it back again.
+func (app *App) checkSessions(ctx context.Context) error {
+	rec := httptest.NewRecorder()
+	err := app.Sessions.Load(httptest.NewRequest("GET", "/", nil)).PutString(rec, "healthcheck", health.StatusOK)
+	if err != nil {
+		return err
+	}
+
+	r := httptest.NewRequest("GET", "/", nil)
+	for _, cookie := range rec.Result().Cookies() {
+		r.AddCookie(cookie)
+	}
+
+	v, err := app.Sessions.Load(r).GetString("healthcheck")
+	if err != nil {
+		return err
+	}
+	if v != health.StatusOK {
+		return errors.New("session value didn't survive a round trip")
+	}
+
+	return nil
+}
@@ -1,28 +1,235 @@
 package main
 
 import (
+	"context"
+	"net"
 	"net/http"
+
+	"snippetbox.org/pkg/models"
 )
 
-func (app *App) LoggedIn(r *http.Request) (bool, error) {
+// requestAuth holds who is logged in for a request and what they may do. It's
+// looked up the first time anything asks, and kept for the rest of the
+// request, so changes a handler makes to the user show from the next request.
+type requestAuth struct {
+	loaded      bool
+	user        *models.User
+	permissions map[string]bool
+}
+
+const authKey = contextKey("auth")
+
+// auth returns the request's requestAuth, looking it up if nothing has yet. A
+// request that didn't pass through Authenticate gets it looked up afresh.
+func (app *App) auth(r *http.Request) (*requestAuth, error) {
+	a, ok := r.Context().Value(authKey).(*requestAuth)
+	if !ok {
+		a = &requestAuth{}
+	}
+	if a.loaded {
+		return a, nil
+	}
+
+	user, err := app.loadUser(r)
+	if err != nil {
+		return nil, err
+	}
+
+	perms, err := app.Permissions(r.Context(), user)
+	if err != nil {
+		return nil, err
+	}
+
+	a.user, a.permissions, a.loaded = user, perms, true
+	return a, nil
+}
+
+// loadUser returns the user whose session the request carries, or nil if
+// there isn't one or it's no longer valid.
+func (app *App) loadUser(r *http.Request) (*models.User, error) {
 	// Load the session data for the current request, and use the Exists() method
 	// to check if it contains a currentUserID key. This returns true if the
 	// key is in the session data; false otherwise.
 	session := app.Sessions.Load(r)
 	loggedIn, err := session.Exists("currentUserID")
+	if err != nil || !loggedIn {
+		return nil, err
+	}
+
+	// A session only counts if it was created since the user's last password
+	// reset, which bumps the session version stored against the user.
+	id, err := session.GetInt("currentUserID")
+	if err != nil {
+		return nil, err
+	}
+	version, err := session.GetInt("sessionVersion")
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := app.Users.GetUser(r.Context(), id)
+	if err != nil || user == nil {
+		return nil, err
+	}
+
+	if user.Disabled || user.SessionVersion != version {
+		return nil, nil
+	}
+	return user, nil
+}
+
+func (app *App) LoggedIn(r *http.Request) (bool, error) {
+	a, err := app.auth(r)
 	if err != nil {
 		return false, err
 	}
 
-	return loggedIn, nil
+	return a.user != nil, nil
+}
+
+// CurrentUser returns the logged in user for the request, or nil if nobody is
+// logged in.
+func (app *App) CurrentUser(r *http.Request) (*models.User, error) {
+	a, err := app.auth(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.user, nil
+}
+
+// CurrentPermissions returns what the user logged in for the request may do,
+// which is nothing if nobody is.
+func (app *App) CurrentPermissions(r *http.Request) (map[string]bool, error) {
+	a, err := app.auth(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.permissions, nil
 }
 
-func (app *App) AdminLoggedIn(r *http.Request) (bool, error) {
-	Admin := app.Admin.Load(r)
-	adminLoggedIn, err := Admin.Exists("currentAdminID")
+// Permissions returns what the user is allowed to do. While two-factor
+// authentication is required for admins, an admin who hasn't set it up only
+// gets the permissions of the default role.
+func (app *App) Permissions(ctx context.Context, user *models.User) (map[string]bool, error) {
+	if user == nil {
+		return map[string]bool{}, nil
+	}
+
+	if user.Role == models.RoleAdmin && !user.TOTPEnabled {
+		required, err := app.Users.RequireAdmin2FA(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if required {
+			return app.Users.RolePermissions(ctx, models.DefaultRole)
+		}
+	}
+
+	return user.Permissions, nil
+}
+
+// Can reports whether the user logged in for the request has the permission.
+func (app *App) Can(r *http.Request, perm string) (bool, error) {
+	perms, err := app.CurrentPermissions(r)
 	if err != nil {
 		return false, err
 	}
 
-	return adminLoggedIn, nil
+	return perms[perm], nil
+}
+
+// CompleteLogin finishes logging a user in once their password (and second
+// factor, if they have one) has been checked, then redirects them. Admins who
+// don't meet the two-factor policy are sent to set up 2FA.
+func (app *App) CompleteLogin(w http.ResponseWriter, r *http.Request, user *models.User) {
+	session := app.Sessions.Load(r)
+
+	if user.Disabled {
+		err := session.PutString(w, "flash", app.T(r, "Your account has been disabled."))
+		if err != nil {
+			app.ServerError(w, err)
+			return
+		}
+
+		http.Redirect(w, r, "/user/login", http.StatusSeeOther)
+		return
+	}
+
+	err := app.Users.RecordLogin(r.Context(), user.ID)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	app.Metrics.Logins.Inc()
+
+	err = app.AuditAs(r, user.ID, models.AuditLogin, user.Email)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	needs2FA := false
+	if user.Role == models.RoleAdmin && !user.TOTPEnabled {
+		required, err := app.Users.RequireAdmin2FA(r.Context())
+		if err != nil {
+			app.ServerError(w, err)
+			return
+		}
+		needs2FA = required
+	}
+
+	for _, key := range []string{"pendingUserID", "pendingSince"} {
+		err = session.Remove(w, key)
+		if err != nil {
+			app.ServerError(w, err)
+			return
+		}
+	}
+
+	// Add the ID of the current user to the session, so that they are now 'logged
+	// in'. Record the user's current session version alongside it; resetting the
+	// password bumps the version, which logs out every session created before it.
+	err = session.PutInt(w, "currentUserID", user.ID)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	err = session.PutInt(w, "sessionVersion", user.SessionVersion)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	if needs2FA {
+		msg := app.T(r, "Admin accounts must use two-factor authentication. Set it up to get admin access.")
+		err = session.PutString(w, "flash", msg)
+		if err != nil {
+			app.ServerError(w, err)
+			return
+		}
+
+		http.Redirect(w, r, "/user/2fa", http.StatusSeeOther)
+		return
+	}
+
+	if user.Can(models.PermCreateSnippets) {
+		// Redirect the user to the Add Snippet page.
+		http.Redirect(w, r, "/snippet/new", http.StatusSeeOther)
+		return
+	}
+
+	http.Redirect(w, r, "/", http.StatusSeeOther)
+}
+
+// clientIP returns the IP address of the client that made the request.
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
 }
\ No newline at end of file
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"snippetbox.org/pkg/models"
+)
+
+// countingUsers counts the users looked up through it.
+type countingUsers struct {
+	models.UserStore
+	lookups int
+}
+
+func (c *countingUsers) GetUser(ctx context.Context, id int) (*models.User, error) {
+	c.lookups++
+	return c.UserStore.GetUser(ctx, id)
+}
+
+func TestUserLoadedOncePerRequest(t *testing.T) {
+	ctx := context.Background()
+	app, db := newTestApp(t)
+
+	err := db.InsertUser(ctx, "Alice", "alice@example.com", "correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	user, err := db.GetUserByEmail(ctx, "alice@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	users := &countingUsers{UserStore: db}
+	app.Users = users
+	client := newTestClient(app)
+	err = client.logIn(user)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	users.lookups = 0
+	var loggedIn, can bool
+	var current *models.User
+	client.do(func(w http.ResponseWriter, r *http.Request) {
+		loggedIn, _ = app.LoggedIn(r)
+		current, _ = app.CurrentUser(r)
+		can, _ = app.Can(r, models.PermCreateSnippets)
+		app.RenderHTML(w, r, "home.page.html", nil)
+	}, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	if !loggedIn || current == nil || current.ID != user.ID || !can {
+		t.Errorf("got logged in %v, user %v and can %v", loggedIn, current, can)
+	}
+	if users.lookups != 1 {
+		t.Errorf("looked the user up %d times; want 1", users.lookups)
+	}
+
+	// A session from before the user's sessions were ended doesn't count.
+	err = db.LogoutEverywhere(ctx, user.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.do(func(w http.ResponseWriter, r *http.Request) {
+		loggedIn, _ = app.LoggedIn(r)
+	}, httptest.NewRequest(http.MethodGet, "/", nil))
+	if loggedIn {
+		t.Error("a logged out session still counts as logged in")
+	}
+}
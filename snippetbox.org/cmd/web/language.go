@@ -0,0 +1,60 @@
+package main
+
+import (
+	"net/http"
+	"strings"
+
+	"snippetbox.org/pkg/i18n"
+)
+
+// Locale returns the language to show the request in: the one the user picked,
+// if they have, otherwise the best match for their browser's Accept-Language.
+func (app *App) Locale(r *http.Request) *i18n.Locale {
+	// A session that can't be read just means we don't know the user's
+	// choice, which isn't worth failing the request over.
+	lang, err := app.Sessions.Load(r).GetString("lang")
+	if err == nil {
+		if l := app.Locales.Lookup(lang); l != nil {
+			return l
+		}
+	}
+
+	return app.Locales.Match(r.Header.Get("Accept-Language"))
+}
+
+// T translates a message into the request's language. It's for messages set
+// in handlers, such as flashes; templates use their own T function.
+func (app *App) T(r *http.Request, msg string, args ...interface{}) string {
+	return app.Locale(r).T(msg, args...)
+}
+
+// SetLanguage saves the language the user picked in their session, then sends
+// them back to the page they picked it on.
+func (app *App) SetLanguage(w http.ResponseWriter, r *http.Request) {
+	err := r.ParseForm()
+	if err != nil {
+		app.ClientError(w, http.StatusBadRequest)
+		return
+	}
+
+	lang := r.PostForm.Get("lang")
+	if app.Locales.Lookup(lang) == nil {
+		app.ClientError(w, http.StatusBadRequest)
+		return
+	}
+
+	err = app.Sessions.Load(r).PutString(w, "lang", lang)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	// Only follow local paths, so the form can't be used to redirect
+	// somewhere else.
+	to := r.PostForm.Get("return")
+	if !strings.HasPrefix(to, "/") || strings.HasPrefix(to, "//") || strings.HasPrefix(to, "/\\") {
+		to = "/"
+	}
+
+	http.Redirect(w, r, to, http.StatusSeeOther)
+}
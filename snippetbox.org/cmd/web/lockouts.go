@@ -0,0 +1,150 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"snippetbox.org/pkg/models"
+	"snippetbox.org/pkg/throttle"
+)
+
+// Throttle keys are prefixed with what they count failures for.
+func accountKey(email string) string {
+	return "account:" + strings.ToLower(strings.TrimSpace(email))
+}
+
+func ipKey(ip string) string {
+	return "ip:" + ip
+}
+
+func (app *App) throttleFor(key string) *throttle.Throttle {
+	if strings.HasPrefix(key, "ip:") {
+		return app.IPThrottle
+	}
+	return app.AccountThrottle
+}
+
+// loginWait returns how long a login for the email from the request's client
+// has to wait before the password may be checked.
+func (app *App) loginWait(r *http.Request, email string) (time.Duration, error) {
+	wait, err := app.AccountThrottle.Wait(r.Context(), accountKey(email))
+	if err != nil {
+		return 0, err
+	}
+
+	ipWait, err := app.IPThrottle.Wait(r.Context(), ipKey(clientIP(r)))
+	if err != nil {
+		return 0, err
+	}
+
+	if ipWait > wait {
+		wait = ipWait
+	}
+	return wait, nil
+}
+
+// loginFailed records a failed login against both the account and the client
+// IP, or only the IP if email is empty because the attempt didn't say whose
+// account it was for. If it locks the account out, the account's owner is sent
+// an email, unless they've already had a few lately.
+func (app *App) loginFailed(r *http.Request, email string) error {
+	_, err := app.IPThrottle.Fail(r.Context(), ipKey(clientIP(r)))
+	if err != nil || email == "" {
+		return err
+	}
+
+	locked, err := app.AccountThrottle.Fail(r.Context(), accountKey(email))
+	if err != nil || !locked {
+		return err
+	}
+
+	user, err := app.Users.GetUserByEmail(r.Context(), email)
+	if err != nil || user == nil {
+		return err
+	}
+
+	ok, _, err := app.LockoutMailLimit.Allow(r.Context(), strconv.Itoa(user.ID))
+	if err != nil || !ok {
+		return err
+	}
+
+	body := fmt.Sprintf("Hi %s,\n\nThere have been too many failed attempts to log in to your Snippetbox account, "+
+		"so it has been locked for %v. If this wasn't you, consider resetting your password.\n",
+		user.Name, app.AccountThrottle.Lockout)
+	return app.Mailer.Send(user.Email, "Your Snippetbox account has been locked", body)
+}
+
+// Lockout is a locked out key, for display on the lockouts page.
+type Lockout struct {
+	Key string
+	throttle.Entry
+}
+
+func (app *App) Lockouts(w http.ResponseWriter, r *http.Request) {
+	lockouts := []*Lockout{}
+	for _, t := range []*throttle.Throttle{app.AccountThrottle, app.IPThrottle} {
+		entries, err := t.Locked(r.Context())
+		if err != nil {
+			app.ServerError(w, err)
+			return
+		}
+
+		for key, e := range entries {
+			if t == app.throttleFor(key) {
+				lockouts = append(lockouts, &Lockout{Key: key, Entry: e})
+			}
+		}
+	}
+	sort.Slice(lockouts, func(i, j int) bool { return lockouts[i].Key < lockouts[j].Key })
+
+	session := app.Sessions.Load(r)
+	flash, err := session.PopString(w, "flash")
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	app.RenderHTML(w, r, "admin.lockouts.page.html", &HTMLData{
+		Flash:    flash,
+		Lockouts: lockouts,
+	})
+}
+
+func (app *App) Unlock(w http.ResponseWriter, r *http.Request) {
+	err := r.ParseForm()
+	if err != nil {
+		app.ClientError(w, http.StatusBadRequest)
+		return
+	}
+
+	key := r.PostForm.Get("key")
+	if key == "" {
+		app.ClientError(w, http.StatusBadRequest)
+		return
+	}
+
+	err = app.throttleFor(key).Reset(r.Context(), key)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	err = app.Audit(r, models.AuditLockoutCleared, key)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	session := app.Sessions.Load(r)
+	err = session.PutString(w, "flash", app.T(r, "Unlocked %s.", key))
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	http.Redirect(w, r, "/admin/lockouts", http.StatusSeeOther)
+}
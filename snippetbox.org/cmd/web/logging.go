@@ -0,0 +1,104 @@
+package main
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// Every response carries the ID its access log entry was written with, so an
+// error report can be matched up with the logs.
+const requestIDHeader = "X-Request-ID"
+
+// NewLogger returns a logger writing JSON lines to stdout, dropping anything
+// below the named level (debug, info, warn or error).
+func NewLogger(level string) (*slog.Logger, error) {
+	var l slog.Level
+	err := l.UnmarshalText([]byte(strings.ToUpper(level)))
+	if err != nil {
+		return nil, err
+	}
+
+	return slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: l})), nil
+}
+
+func newRequestID() string {
+	b := make([]byte, 8)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// requestID returns the ID LogRequest gave the request that w responds to. It
+// reads it back from the response headers, which every wrapping writer
+// shares.
+func requestID(w http.ResponseWriter) string {
+	return w.Header().Get(requestIDHeader)
+}
+
+// statusWriter records the status code and size of a response.
+type statusWriter struct {
+	http.ResponseWriter
+	status int
+	size   int
+}
+
+func (sw *statusWriter) WriteHeader(status int) {
+	if sw.status == 0 {
+		sw.status = status
+	}
+	sw.ResponseWriter.WriteHeader(status)
+}
+
+func (sw *statusWriter) Write(b []byte) (int, error) {
+	if sw.status == 0 {
+		sw.status = http.StatusOK
+	}
+	n, err := sw.ResponseWriter.Write(b)
+	sw.size += n
+	return n, err
+}
+
+// LogRequest gives each request an ID and writes an access log entry for it
+// once it's been served. Server errors are logged at error level and client
+// errors at warn level.
+func (app *App) LogRequest(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		id := newRequestID()
+		w.Header().Set(requestIDHeader, id)
+
+		sw := &statusWriter{ResponseWriter: w}
+		next.ServeHTTP(sw, r)
+
+		if sw.status == 0 {
+			sw.status = http.StatusOK
+		}
+
+		// This loads the session afresh from the request cookie, so it's who
+		// the user was when they made the request.
+		userID, _ := app.Sessions.Load(r).GetInt("currentUserID")
+
+		level := slog.LevelInfo
+		if sw.status >= 500 {
+			level = slog.LevelError
+		} else if sw.status >= 400 {
+			level = slog.LevelWarn
+		}
+
+		app.Logger.LogAttrs(r.Context(), level, "request",
+			slog.String("request_id", id),
+			slog.String("remote_addr", r.RemoteAddr),
+			slog.String("proto", r.Proto),
+			slog.String("method", r.Method),
+			slog.String("uri", r.URL.RequestURI()),
+			slog.Int("user_id", userID),
+			slog.Int("status", sw.status),
+			slog.Int("size", sw.size),
+			slog.Duration("latency", time.Since(start)),
+		)
+	})
+}
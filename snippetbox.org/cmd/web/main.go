@@ -1,66 +1,403 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"flag"
 	"log"
+	"io/fs"
+	"log/slog"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
 	"time"
 
+	"snippetbox.org/pkg/health"
+	"snippetbox.org/pkg/i18n"
+	"snippetbox.org/pkg/mailer"
+	"snippetbox.org/pkg/migrate"
 	"snippetbox.org/pkg/models"
+	"snippetbox.org/pkg/models/mysql"
+	"snippetbox.org/pkg/models/sqlite"
+	"snippetbox.org/pkg/ratelimit"
+	"snippetbox.org/pkg/throttle"
+	"snippetbox.org/ui"
 
 	"github.com/alexedwards/scs"
+	"github.com/go-webauthn/webauthn/webauthn"
 	_ "github.com/go-sql-driver/mysql"
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		err := migrateCommand(os.Args[2:])
+		if err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
 	addr := flag.String("addr", ":4000", "HTTP network address")
-	dsn := flag.String("dsn", "sb:pass@/snippetbox?parseTime=true", "MySQL DSN")
-	htmlDir := flag.String("html-dir", "./ui/html", "Path to HTML templates")
-	secret := flag.String("secret", "sb04y4ER5irMeOppyf5qdJG9kQSjWw2F", "Secret key")
-	top := flag.String("top", "sb04y4ER5irMeOppyf5qdJG9kQSjWw8G", "Secret key top")
-	staticDir := flag.String("static-dir", "./ui/static", "Path to static assets")
+	baseURL := flag.String("base-url", "https://localhost:4000", "Public URL of the site, used for links in emails")
+	database := flag.String("db", "mysql", "Database to keep snippets and users in (mysql or sqlite)")
+	dsn := flag.String("dsn", "", "MySQL DSN, or SQLite database file (defaults to "+defaultDSNs["mysql"]+" or "+defaultDSNs["sqlite"]+")")
+	dbTimeout := flag.Duration("db-timeout", 5*time.Second, "Longest a database query may take before the request gets a 504 (0 for no limit)")
+	migrateMode := flag.String("migrate", "check", "What to do about pending migrations at startup: check (refuse to start), up (apply them) or off")
+	dev := flag.Bool("dev", false, "Serve templates and static files from -html-dir and -static-dir, reloading templates when they change")
+	htmlDir := flag.String("html-dir", "./ui/html", "Path to HTML templates in -dev mode")
+	lang := flag.String("lang", "en", "Language to use when the browser doesn't ask for one the site has")
+	secret := flag.String("secret", "", "Secret key for session cookies (exactly 32 bytes)")
+	smtpAddr := flag.String("smtp-addr", "", "SMTP server address (emails are logged if empty)")
+	smtpFrom := flag.String("smtp-from", "Snippetbox <no-reply@snippetbox.org>", "Sender address for emails")
+	smtpUser := flag.String("smtp-user", "", "SMTP username")
+	smtpPass := flag.String("smtp-pass", "", "SMTP password")
+	oidcIssuer := flag.String("oidc-issuer", "", "OpenID Connect issuer URL (SSO is disabled if empty)")
+	oidcClientID := flag.String("oidc-client-id", "", "OpenID Connect client ID")
+	oidcClientSecret := flag.String("oidc-client-secret", "", "OpenID Connect client secret")
+	oidcRedirectURL := flag.String("oidc-redirect-url", "https://localhost:4000/user/login/oidc/callback", "OpenID Connect redirect URL")
+	oidcAdminGroup := flag.String("oidc-admin-group", "", "Group whose members are admins (admin status isn't synced if empty)")
+	oidcGroupsClaim := flag.String("oidc-groups-claim", "groups", "ID token claim listing the user's groups")
+	rpID := flag.String("rp-id", "localhost", "WebAuthn relying party ID (the site's domain)")
+	rpOrigin := flag.String("rp-origin", "https://localhost:4000", "WebAuthn relying party origin")
+	throttleStore := flag.String("throttle-store", "memory", "Where to keep failed login counts (memory or mysql)")
+	rateLimitStore := flag.String("rate-limit-store", "memory", "Where to keep rate limit buckets (memory or mysql)")
+	rateGlobal := flag.String("rate-global", "300/1m", "Requests allowed per client across the whole site")
+	rateAuth := flag.String("rate-auth", "20/1m", "Signup, login and password reset attempts allowed per client")
+	rateWrite := flag.String("rate-write", "30/1m", "Snippets created, deleted or reported per client")
+	rateLockoutMail := flag.String("rate-lockout-mail", "3/24h", "Account locked emails sent per account")
+	rateResetMail := flag.String("rate-reset-mail", "3/1h", "Password reset emails sent per address")
+	staticDir := flag.String("static-dir", "./ui/static", "Path to static assets in -dev mode")
+	httpAddr := flag.String("http-addr", "", "Plain HTTP address that redirects to HTTPS and answers ACME challenges, such as :80")
+	acmeDomains := flag.String("acme-domains", "", "Comma-separated domains to get certificates for by ACME (the TLS files are used if empty)")
+	acmeCache := flag.String("acme-cache", "./tls/acme", "Directory to keep ACME certificates and account keys in")
+	acmeEmail := flag.String("acme-email", "", "Contact email for the ACME account")
+	acmeDirectory := flag.String("acme-directory", "", "ACME directory URL (defaults to Let's Encrypt)")
+	acmeCACert := flag.String("acme-ca-cert", "", "PEM file of extra roots to trust for the ACME directory, for test CAs like Pebble")
 	tlsCert := flag.String("tls-cert", "./tls/cert.pem", "Path to TLS certificate")
 	tlsKey := flag.String("tls-key", "./tls/key.pem", "Path to TLS key")
+	tlsMinVersion := flag.String("tls-min-version", "1.2", "Lowest TLS version to accept (1.2 or 1.3)")
+	tlsCiphers := flag.String("tls-ciphers", "modern", "TLS 1.2 cipher suites to accept: modern, intermediate, or a comma-separated list of suite names")
+	hstsMaxAge := flag.Duration("hsts-max-age", 365*24*time.Hour, "How long browsers should only use HTTPS (0 turns HSTS off)")
+	hstsSubdomains := flag.Bool("hsts-include-subdomains", false, "Apply HSTS to subdomains too")
+	hstsPreload := flag.Bool("hsts-preload", false, "Ask to be included in browsers' HSTS preload lists")
+	metricsAddr := flag.String("metrics-addr", "", "Separate plain HTTP address to serve /metrics on, such as localhost:9090")
+	metricsToken := flag.String("metrics-token", "", "Bearer token for /metrics (also serves it on the main listener)")
+	logLevel := flag.String("log-level", "info", "Lowest level to log (debug, info, warn or error)")
+	shutdownTimeout := flag.Duration("shutdown-timeout", 30*time.Second, "How long to wait for in-flight requests when shutting down")
+	trashDays := flag.Int("trash-days", 30, "Days deleted snippets can be restored for before they're purged")
+
+	err := loadConfig(flag.CommandLine, os.Args[1:], false)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	err = validateSecret(*secret)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	site, err := parseBaseURL(*baseURL)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	minVersion, err := parseTLSVersion(*tlsMinVersion)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	cipherSuites, err := parseTLSCiphers(*tlsCiphers)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	hsts, err := hstsHeader(*hstsMaxAge, *hstsSubdomains, *hstsPreload)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	logger, err := NewLogger(*logLevel)
+	if err != nil {
+		log.Fatal(err)
+	}
+	// Send anything still using the log package through the same JSON output.
+	slog.SetDefault(logger)
+
+	db, stores := connect(*database, *dsn)
 
-	flag.Parse()
+	err = startupMigrations(stores, *migrateMode, logger)
+	if err != nil {
+		log.Fatal(err)
+	}
 
-	db := connect(*dsn)
-	defer db.Close()
+	// Stop on SIGINT or SIGTERM. Everything long-running watches ctx, and the
+	// background workers are waited for before the database is closed.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 
-	sessionManager := scs.NewCookieManager(*secret)
+	var workers sync.WaitGroup
+	background := func(fn func(ctx context.Context)) {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			fn(ctx)
+		}()
+	}
+
+	metrics := NewMetrics(db)
+
+	sessionManager := scs.NewManager(metrics.NewSessionStore(*secret))
 	sessionManager.Lifetime(12 * time.Hour)
 	sessionManager.Persist(true)
 	sessionManager.Secure(true)
 
-	sessionAdmin := scs.NewCookieAdmin(*top)
-	sessionAdmin.Lifetime(12 * time.Hour)
-	sessionAdmin.Persist(true)
-	sessionAdmin.Secure(true)
+	var mail mailer.Mailer = &mailer.Log{}
+	if *smtpAddr != "" {
+		mail = &mailer.SMTP{
+			Addr:     *smtpAddr,
+			From:     *smtpFrom,
+			Username: *smtpUser,
+			Password: *smtpPass,
+		}
+	}
+
+	webAuthn, err := webauthn.New(&webauthn.Config{
+		RPDisplayName: "Snippetbox",
+		RPID:          *rpID,
+		RPOrigins:     []string{*rpOrigin},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var store throttle.Store
+	switch *throttleStore {
+	case "memory":
+		// Entries can be forgotten after the throttles' one hour Window.
+		store = throttle.NewMemStore(time.Hour)
+	case "mysql":
+		if *database != "mysql" {
+			log.Fatal("-throttle-store mysql needs -db mysql")
+		}
+		// Rows can be deleted after the throttles' one hour Window, like the
+		// memory store's entries.
+		mysqlStore := throttle.NewMySQLStore(db)
+		background(func(ctx context.Context) {
+			runEvery(ctx, time.Hour, func() {
+				if err := mysqlStore.Prune(ctx, time.Now().Add(-time.Hour)); err != nil {
+					logger.Error("pruning login throttles", "error", err.Error())
+				}
+			})
+		})
+		store = throttle.WithTimeout(mysqlStore, *dbTimeout)
+	default:
+		log.Fatalf("unknown throttle store %q", *throttleStore)
+	}
+
+	var limitStore ratelimit.Store
+	switch *rateLimitStore {
+	case "memory":
+		limitStore = ratelimit.NewMemStore()
+	case "mysql":
+		if *database != "mysql" {
+			log.Fatal("-rate-limit-store mysql needs -db mysql")
+		}
+		mysqlStore := ratelimit.NewMySQLStore(db)
+		background(func(ctx context.Context) {
+			runEvery(ctx, time.Hour, func() {
+				if err := mysqlStore.Prune(ctx, time.Now()); err != nil {
+					logger.Error("pruning rate limits", "error", err.Error())
+				}
+			})
+		})
+		limitStore = ratelimit.WithTimeout(mysqlStore, *dbTimeout)
+	default:
+		log.Fatalf("unknown rate limit store %q", *rateLimitStore)
+	}
+
+	limiters := make(map[string]*ratelimit.Limiter)
+	for name, spec := range map[string]string{"global": *rateGlobal, "auth": *rateAuth, "write": *rateWrite, "lockout-mail": *rateLockoutMail, "reset-mail": *rateResetMail} {
+		limit, err := ratelimit.ParseLimit(spec)
+		if err != nil {
+			log.Fatal(err)
+		}
+		limiters[name] = ratelimit.New(name, limit, limitStore)
+	}
+
+	// Client IPs get more leeway than accounts, since many users can share one
+	// address.
+	accountThrottle := throttle.New(store)
+	ipThrottle := throttle.New(store)
+	ipThrottle.FreeAttempts = 10
+	ipThrottle.Threshold = 50
+
+	var sso *OIDC
+	if *oidcIssuer != "" {
+		sso, err = NewOIDC(context.Background(), *oidcIssuer, *oidcClientID, *oidcClientSecret, *oidcRedirectURL,
+			*oidcAdminGroup, *oidcGroupsClaim)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	// The templates and static files built into the binary are used unless
+	// we're in development.
+	htmlFS, err := fs.Sub(ui.Files, "html")
+	if err != nil {
+		log.Fatal(err)
+	}
+	staticFS, err := fs.Sub(ui.Files, "static")
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *dev {
+		htmlFS = os.DirFS(*htmlDir)
+		staticFS = os.DirFS(*staticDir)
+	}
+
+	localesFS, err := fs.Sub(ui.Files, "locales")
+	if err != nil {
+		log.Fatal(err)
+	}
+	locales, err := i18n.Load(localesFS, *lang)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	templates, err := NewTemplates(htmlFS, locales)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var acmeConfig *ACMEConfig
+	if *acmeDomains != "" {
+		acmeConfig = &ACMEConfig{
+			Domains:      strings.Split(*acmeDomains, ","),
+			CacheDir:     *acmeCache,
+			Email:        *acmeEmail,
+			DirectoryURL: *acmeDirectory,
+			CACert:       *acmeCACert,
+		}
+	}
 
 	app := &App{
+		ACME:      acmeConfig,
+		AccountThrottle: accountThrottle,
 		Addr:      *addr,
-		Database:  &models.Database{db},
-		HTMLDir:   *htmlDir,
+		AuthLimit: limiters["auth"],
+		Background: background,
+		BaseURL:   site,
+		GlobalLimit: limiters["global"],
+		Health:    health.New(2 * time.Second),
+		HSTS:      hsts,
+		HTTPAddr:  *httpAddr,
+		IPThrottle: ipThrottle,
+		Locales:   locales,
+		LockoutMailLimit: limiters["lockout-mail"],
+		Logger:    logger,
+		Mailer:    mail,
+		Metrics:   metrics,
+		MetricsAddr: *metricsAddr,
+		MetricsToken: *metricsToken,
+		OIDC:      sso,
+		ResetMailLimit: limiters["reset-mail"],
 		Sessions:   sessionManager,
-		Admin:      sessionAdmin,
-		StaticDir: *staticDir,
+		ShutdownTimeout: *shutdownTimeout,
+		Snippets:  models.SnippetsWithTimeout(stores, *dbTimeout),
+		Static:    staticFS,
+		Templates: templates,
 		TLSCert:   *tlsCert,
 		TLSKey:    *tlsKey,
+		TLSCipherSuites: cipherSuites,
+		TLSMinVersion: minVersion,
+		TrashDays: *trashDays,
+		Users:     models.UsersWithTimeout(stores, *dbTimeout),
+		WebAuthn:  webAuthn,
+		WriteLimit: limiters["write"],
 	}
 
-	app.RunServer()
+	app.RegisterHealthChecks(*database, db)
 
-}
+	background(func(ctx context.Context) {
+		runEvery(ctx, time.Hour, func() {
+			app.PurgeTrash(ctx)
+		})
+	})
+
+	if *dev {
+		background(func(ctx context.Context) {
+			if err := app.WatchTemplates(ctx, *htmlDir); err != nil {
+				logger.Error(err.Error())
+			}
+		})
+	}
 
-func connect(dsn string) *sql.DB {
-	db, err := sql.Open("mysql", dsn)
+	if app.MetricsAddr != "" {
+		background(func(ctx context.Context) {
+			if err := app.RunMetricsServer(ctx); err != nil {
+				logger.Error(err.Error())
+			}
+		})
+	}
+
+	err = app.RunServer(ctx)
 	if err != nil {
-		log.Fatal(err)
+		logger.Error(err.Error())
 	}
 
-	if err := db.Ping(); err != nil {
-		log.Fatal(err)
+	stop()
+	workers.Wait()
+	db.Close()
+	logger.Info("stopped")
+
+	if err != nil {
+		os.Exit(1)
 	}
+}
+
+var defaultDSNs = map[string]string{
+	"mysql":  "sb:pass@/snippetbox?parseTime=true",
+	"sqlite": "./snippetbox.db",
+}
 
-	return db
+// backend is what each kind of database provides: the stores, and the
+// migrations for their schema.
+type backend interface {
+	models.SnippetStore
+	models.UserStore
+	Migrator() (*migrate.Migrator, error)
+}
+
+// connect opens the database, returning the connection pool itself for
+// anything that needs it directly.
+func connect(database, dsn string) (*sql.DB, backend) {
+	if dsn == "" {
+		dsn = defaultDSNs[database]
+	}
+
+	switch database {
+	case "mysql":
+		db, err := sql.Open("mysql", dsn)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if err := db.Ping(); err != nil {
+			log.Fatal(err)
+		}
+
+		return db, mysql.New(db)
+	case "sqlite":
+		store, err := sqlite.Open(dsn)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		return store.DB, store
+	default:
+		log.Fatalf("unknown database %q", database)
+		return nil, nil
+	}
 }
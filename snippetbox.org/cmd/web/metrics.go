@@ -0,0 +1,162 @@
+package main
+
+import (
+	"crypto/subtle"
+	"database/sql"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/alexedwards/scs"
+	"github.com/alexedwards/scs/stores/cookiestore"
+	"github.com/bmizerany/pat"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Metrics holds the Prometheus metrics the application exports.
+type Metrics struct {
+	Registry *prometheus.Registry
+
+	Requests      *prometheus.CounterVec
+	Latency       *prometheus.HistogramVec
+	SessionErrors *prometheus.CounterVec
+
+	SnippetsCreated prometheus.Counter
+	Signups         prometheus.Counter
+	Logins          prometheus.Counter
+	LoginsFailed    prometheus.Counter
+}
+
+// NewMetrics registers the application's metrics, along with the Go runtime,
+// process and database connection pool stats.
+func NewMetrics(db *sql.DB) *Metrics {
+	m := &Metrics{
+		Registry: prometheus.NewRegistry(),
+		Requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "snippetbox_http_requests_total",
+			Help: "HTTP requests served, by route pattern, method and status code.",
+		}, []string{"route", "method", "code"}),
+		Latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "snippetbox_http_request_duration_seconds",
+			Help:    "Time taken to serve HTTP requests, by route pattern and method.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"route", "method"}),
+		SessionErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "snippetbox_session_errors_total",
+			Help: "Sessions that failed to load or save, by operation.",
+		}, []string{"op"}),
+		SnippetsCreated: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "snippetbox_snippets_created_total",
+			Help: "Snippets created.",
+		}),
+		Signups: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "snippetbox_signups_total",
+			Help: "Users who signed up.",
+		}),
+		Logins: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "snippetbox_logins_total",
+			Help: "Successful logins.",
+		}),
+		LoginsFailed: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "snippetbox_logins_failed_total",
+			Help: "Login attempts with a wrong password or second factor.",
+		}),
+	}
+
+	m.Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		collectors.NewDBStatsCollector(db, "snippetbox"),
+		m.Requests, m.Latency, m.SessionErrors,
+		m.SnippetsCreated, m.Signups, m.Logins, m.LoginsFailed,
+	)
+
+	return m
+}
+
+// sessionStore wraps the cookie store to count sessions that fail to load or
+// save. The errors are still returned to the session manager as they were.
+type sessionStore struct {
+	*cookiestore.CookieStore
+	metrics *Metrics
+}
+
+// NewSessionStore returns a cookie store for key that counts its failures.
+func (m *Metrics) NewSessionStore(key string) scs.Store {
+	return &sessionStore{CookieStore: cookiestore.New([]byte(key)), metrics: m}
+}
+
+func (s *sessionStore) Find(token string) ([]byte, bool, error) {
+	b, found, err := s.CookieStore.Find(token)
+	if err != nil {
+		s.metrics.SessionErrors.WithLabelValues("load").Inc()
+	}
+	return b, found, err
+}
+
+// MakeToken is what the session manager calls to save a session in a cookie.
+func (s *sessionStore) MakeToken(b []byte, expiry time.Time) (string, error) {
+	token, err := s.CookieStore.MakeToken(b, expiry)
+	if err != nil {
+		s.metrics.SessionErrors.WithLabelValues("save").Inc()
+	}
+	return token, err
+}
+
+// Handler serves the metrics. If token isn't empty, requests must send it as a
+// bearer token.
+func (m *Metrics) Handler(token string) http.Handler {
+	h := promhttp.HandlerFor(m.Registry, promhttp.HandlerOpts{})
+	if token == "" {
+		return h
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		want := []byte("Bearer " + token)
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), want) != 1 {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+
+		h.ServeHTTP(w, r)
+	})
+}
+
+// instrument counts and times requests to a route. The route is labelled with
+// its pattern rather than the request path, so /snippet/1 and /snippet/2 are
+// counted together.
+func (m *Metrics) instrument(pattern string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		sw := &statusWriter{ResponseWriter: w}
+		next.ServeHTTP(sw, r)
+
+		if sw.status == 0 {
+			sw.status = http.StatusOK
+		}
+
+		m.Requests.WithLabelValues(pattern, r.Method, strconv.Itoa(sw.status)).Inc()
+		m.Latency.WithLabelValues(pattern, r.Method).Observe(time.Since(start).Seconds())
+	})
+}
+
+// routeMux is a pat mux that instruments every route it registers.
+type routeMux struct {
+	*pat.PatternServeMux
+	metrics *Metrics
+}
+
+func newRouteMux(metrics *Metrics) *routeMux {
+	return &routeMux{PatternServeMux: pat.New(), metrics: metrics}
+}
+
+func (m *routeMux) Get(pattern string, h http.Handler) {
+	m.PatternServeMux.Get(pattern, m.metrics.instrument(pattern, h))
+}
+
+func (m *routeMux) Post(pattern string, h http.Handler) {
+	m.PatternServeMux.Post(pattern, m.metrics.instrument(pattern, h))
+}
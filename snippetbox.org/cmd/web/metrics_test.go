@@ -0,0 +1,61 @@
+package main
+
+import (
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/alexedwards/scs"
+	dto "github.com/prometheus/client_model/go"
+)
+
+// sessionErrors returns the count of session errors for op.
+func sessionErrors(t *testing.T, m *Metrics, op string) float64 {
+	t.Helper()
+
+	var pb dto.Metric
+	err := m.SessionErrors.WithLabelValues(op).Write(&pb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pb.GetCounter().GetValue()
+}
+
+func TestSessionStoreCountsErrors(t *testing.T) {
+	m := NewMetrics(&sql.DB{})
+	manager := scs.NewManager(m.NewSessionStore(strings.Repeat("k", 32)))
+
+	// A session too big for a cookie fails to save.
+	rr := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	err := manager.Load(r).PutString(rr, "big", strings.Repeat("x", 8192))
+	if err == nil {
+		t.Fatal("saving an oversized session succeeded")
+	}
+
+	if got := sessionErrors(t, m, "save"); got != 1 {
+		t.Errorf("got %v save errors; want 1", got)
+	}
+
+	// A small one saves, and loads back without errors.
+	rr = httptest.NewRecorder()
+	err = manager.Load(r).PutString(rr, "small", "x")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r = httptest.NewRequest(http.MethodGet, "/", nil)
+	for _, c := range rr.Result().Cookies() {
+		r.AddCookie(c)
+	}
+	s, err := manager.Load(r).GetString("small")
+	if err != nil || s != "x" {
+		t.Errorf("got %q, %v; want \"x\", nil", s, err)
+	}
+
+	if got := sessionErrors(t, m, "load"); got != 0 {
+		t.Errorf("got %v load errors; want 0", got)
+	}
+}
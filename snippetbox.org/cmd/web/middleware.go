@@ -1,27 +1,69 @@
 package main
 
 import (
-	"log"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"math"
 	"net/http"
 	"github.com/justinas/nosurf"
+
+	"snippetbox.org/pkg/ratelimit"
 )
 
-func LogRequest(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		pattern := `%s - "%s %s %s"`
-		log.Printf(pattern, r.RemoteAddr, r.Proto, r.Method, r.URL.RequestURI())
+type contextKey string
 
-		next.ServeHTTP(w, r)
-	})
+const cspNonceKey = contextKey("cspNonce")
+
+// cspNonce returns the nonce that scripts in the response must carry.
+func cspNonce(r *http.Request) string {
+	nonce, _ := r.Context().Value(cspNonceKey).(string)
+	return nonce
 }
 
-func SecureHeaders(next http.Handler) http.Handler {
+// SecureHeaders sets the security headers for every response. The
+// Content-Security-Policy only runs scripts that carry the request's nonce,
+// which templates get as .CSPNonce. HSTS is only sent over TLS, and only if
+// it's configured.
+func (app *App) SecureHeaders(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b := make([]byte, 16)
+		_, err := rand.Read(b)
+		if err != nil {
+			app.ServerError(w, err)
+			return
+		}
+		nonce := base64.StdEncoding.EncodeToString(b)
+
+		csp := fmt.Sprintf("default-src 'self'; script-src 'nonce-%s' 'strict-dynamic'; "+
+			"style-src 'self' https://fonts.googleapis.com; font-src https://fonts.gstatic.com; "+
+			"img-src 'self' data:; object-src 'none'; base-uri 'none'; form-action 'self'; "+
+			"frame-ancestors 'none'", nonce)
+
+		w.Header().Set("Content-Security-Policy", csp)
 		w.Header().Set("X-Content-Type-Options", "nosniff")
 		w.Header().Set("X-Frame-Options", "deny")
-		w.Header()["X-XSS-Protection"] = []string{"1; mode=block"}
+		w.Header().Set("Referrer-Policy", "same-origin")
+		w.Header().Set("Permissions-Policy", "camera=(), microphone=(), geolocation=(), payment=(), usb=(), "+
+			"publickey-credentials-create=(self), publickey-credentials-get=(self)")
+		if app.HSTS != "" && r.TLS != nil {
+			w.Header().Set("Strict-Transport-Security", app.HSTS)
+		}
 
-		next.ServeHTTP(w, r)
+		ctx := context.WithValue(r.Context(), cspNonceKey, nonce)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// Authenticate gives the request somewhere to keep the logged in user and their
+// permissions, so that they're only looked up once however many middleware,
+// handlers and templates ask. It needs the session, so it goes inside
+// Sessions.Use.
+func (app *App) Authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), authKey, &requestAuth{})
+		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
 
@@ -42,21 +84,74 @@ func (app *App) RequireLogin(next http.Handler) http.Handler {
 	})
 }
 
-func (app *App) RequireAdmin(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		loggedIn, err := app.AdminLoggedIn(r)
-		if err != nil {
-			app.ServerError(w, err)
-			return
-		}
+// RequirePermission returns middleware that only lets through users whose role
+// grants the permission. Anyone not logged in is sent to the login page.
+func (app *App) RequirePermission(perm string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			loggedIn, err := app.LoggedIn(r)
+			if err != nil {
+				app.ServerError(w, err)
+				return
+			}
 
-		if !loggedIn {
-			http.Redirect(w, r, "/", 302)
-			return
-		}
+			if !loggedIn {
+				http.Redirect(w, r, "/user/login", 302)
+				return
+			}
 
-		next.ServeHTTP(w, r)
-	})
+			can, err := app.Can(r, perm)
+			if err != nil {
+				app.ServerError(w, err)
+				return
+			}
+
+			if !can {
+				app.ClientError(w, http.StatusForbidden)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// RateLimit returns middleware that refuses requests with 429 Too Many Requests
+// once the client has used up the limiter's allowance. Logged in users are
+// limited by user ID, and everyone else by client IP. If the limiter's store
+// fails, the error is logged and the request let through, as the site
+// shouldn't go down with the store.
+func (app *App) RateLimit(l *ratelimit.Limiter) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := "ip:" + clientIP(r)
+			userID, err := app.Sessions.Load(r).GetInt("currentUserID")
+			if err != nil {
+				app.ServerError(w, err)
+				return
+			}
+			if userID != 0 {
+				key = fmt.Sprintf("user:%d", userID)
+			}
+
+			ok, wait, err := l.Allow(r.Context(), key)
+			if err != nil {
+				app.Logger.Error("checking rate limit", "limiter", l.Name, "error", err.Error(), "request_id", requestID(w))
+			}
+
+			if err == nil && !ok {
+				retry := int(math.Ceil(wait.Seconds()))
+				if retry < 1 {
+					retry = 1
+				}
+				w.Header().Set("Retry-After", fmt.Sprint(retry))
+				app.ClientError(w, http.StatusTooManyRequests)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
 }
 
 func NoSurf(next http.HandlerFunc) http.Handler {
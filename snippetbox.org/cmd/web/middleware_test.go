@@ -0,0 +1,53 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"snippetbox.org/pkg/ratelimit"
+)
+
+// brokenLimits is a rate limit store that's down.
+type brokenLimits struct{}
+
+func (brokenLimits) Take(ctx context.Context, key string, l ratelimit.Limit, now time.Time) (bool, time.Duration, error) {
+	return false, 0, errors.New("database is down")
+}
+
+func TestRoutesRateLimit(t *testing.T) {
+	tests := []struct {
+		name  string
+		store ratelimit.Store
+		path  string
+		want  int
+	}{
+		{"Health check over the limit", ratelimit.NewMemStore(), "/healthz", http.StatusOK},
+		{"Static file over the limit", ratelimit.NewMemStore(), "/static/css/main.css", http.StatusOK},
+		{"Page over the limit", ratelimit.NewMemStore(), "/user/login", http.StatusTooManyRequests},
+		{"Health check with the store down", brokenLimits{}, "/healthz", http.StatusOK},
+		{"Page with the store down", brokenLimits{}, "/user/login", http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app, _ := newTestApp(t)
+			app.GlobalLimit = ratelimit.New("global", ratelimit.Per(1, time.Hour), tt.store)
+			routes := app.Routes()
+
+			// The first request uses up the allowance.
+			var rr *httptest.ResponseRecorder
+			for i := 0; i < 2; i++ {
+				rr = httptest.NewRecorder()
+				routes.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, tt.path, nil))
+			}
+
+			if rr.Code != tt.want {
+				t.Errorf("got status %d; want %d", rr.Code, tt.want)
+			}
+		})
+	}
+}
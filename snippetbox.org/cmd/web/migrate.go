@@ -0,0 +1,158 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"snippetbox.org/pkg/migrate"
+)
+
+const migrateUsage = `Usage: web migrate [flags] command
+
+Commands:
+  up           apply every pending migration
+  down [n]     revert the latest n migrations (default 1)
+  status       list the migrations and when they were applied
+  create name  add empty up and down files for a new migration to -dir
+
+Flags:
+`
+
+// migrateCommand runs the migrate subcommand. It reads -db and -dsn the same
+// way the server does, from the config file and environment too.
+func migrateCommand(args []string) error {
+	fs := flag.NewFlagSet("migrate", flag.ExitOnError)
+	database := fs.String("db", "mysql", "Database to migrate (mysql or sqlite)")
+	dsn := fs.String("dsn", "", "MySQL DSN, or SQLite database file (defaults to "+defaultDSNs["mysql"]+" or "+defaultDSNs["sqlite"]+")")
+	dir := fs.String("dir", "", "Directory to add new migrations to (defaults to ./pkg/models/<db>/migrations)")
+	fs.Usage = func() {
+		fmt.Fprint(fs.Output(), migrateUsage)
+		fs.PrintDefaults()
+	}
+
+	err := loadConfig(fs, args, true)
+	if err != nil {
+		return err
+	}
+
+	if fs.NArg() == 0 {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	// Creating a migration only touches the source tree.
+	if fs.Arg(0) == "create" {
+		if fs.NArg() != 2 {
+			return errors.New("migrate create needs a name, such as add_snippet_tags")
+		}
+		if *dir == "" {
+			*dir = "./pkg/models/" + *database + "/migrations"
+		}
+
+		up, down, err := migrate.Create(*dir, fs.Arg(1))
+		if err != nil {
+			return err
+		}
+
+		fmt.Println("created", up)
+		fmt.Println("created", down)
+		return nil
+	}
+
+	db, stores := connect(*database, *dsn)
+	defer db.Close()
+
+	m, err := stores.Migrator()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	switch fs.Arg(0) {
+	case "up":
+		done, err := m.Up(ctx)
+		for _, mig := range done {
+			fmt.Println("applied", mig)
+		}
+		if err == nil && len(done) == 0 {
+			fmt.Println("no pending migrations")
+		}
+		return err
+	case "down":
+		n := 1
+		if fs.NArg() > 1 {
+			n, err = strconv.Atoi(fs.Arg(1))
+			if err != nil || n < 1 {
+				return fmt.Errorf("migrate down: %q isn't a number of migrations", fs.Arg(1))
+			}
+		}
+
+		done, err := m.Down(ctx, n)
+		for _, mig := range done {
+			fmt.Println("reverted", mig)
+		}
+		if err == nil && len(done) == 0 {
+			fmt.Println("no migrations to revert")
+		}
+		return err
+	case "status":
+		states, err := m.Status(ctx)
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+		for _, s := range states {
+			applied := "pending"
+			if s.Applied != nil {
+				applied = "applied " + s.Applied.UTC().Format("2006-01-02 15:04:05 UTC")
+			}
+			fmt.Fprintf(w, "%s\t%s\n", s.Migration, applied)
+		}
+		return w.Flush()
+	default:
+		return fmt.Errorf("migrate: unknown command %q", fs.Arg(0))
+	}
+}
+
+// startupMigrations deals with pending migrations before the server starts:
+// with mode "check" it refuses to start, with "up" it applies them, and with
+// "off" it doesn't look.
+func startupMigrations(stores backend, mode string, logger *slog.Logger) error {
+	if mode == "off" {
+		return nil
+	}
+
+	m, err := stores.Migrator()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	switch mode {
+	case "check":
+		pending, err := m.Pending(ctx)
+		if err != nil {
+			return err
+		}
+		if len(pending) > 0 {
+			return fmt.Errorf("%d database migrations are pending, from %s; run \"web migrate up\" or start with -migrate up",
+				len(pending), pending[0])
+		}
+		return nil
+	case "up":
+		done, err := m.Up(ctx)
+		for _, mig := range done {
+			logger.Info("applied migration", "migration", mig.String())
+		}
+		return err
+	default:
+		return fmt.Errorf("unknown -migrate mode %q", mode)
+	}
+}
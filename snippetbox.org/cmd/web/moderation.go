@@ -0,0 +1,286 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"snippetbox.org/pkg/forms"
+	"snippetbox.org/pkg/models"
+)
+
+// How many past decisions the moderation queue shows.
+const resolvedReportsShown = 20
+
+// reportedSnippet returns the snippet named in the URL, or sends a 404 and
+// returns nil if it doesn't exist or is no longer visible.
+func (app *App) reportedSnippet(w http.ResponseWriter, r *http.Request) *models.Snippet {
+	id, err := strconv.Atoi(r.URL.Query().Get(":id"))
+	if err != nil || id < 1 {
+		app.NotFound(w)
+		return nil
+	}
+
+	snippet, err := app.Snippets.GetSnippet(r.Context(), id)
+	if err != nil {
+		app.ServerError(w, err)
+		return nil
+	}
+	if snippet == nil {
+		app.NotFound(w)
+		return nil
+	}
+
+	return snippet
+}
+
+func (app *App) ReportSnippetForm(w http.ResponseWriter, r *http.Request) {
+	snippet := app.reportedSnippet(w, r)
+	if snippet == nil {
+		return
+	}
+
+	app.RenderHTML(w, r, "report.page.html", &HTMLData{
+		Form:    &forms.ReportSnippet{},
+		Snippet: snippet,
+	})
+}
+
+func (app *App) ReportSnippet(w http.ResponseWriter, r *http.Request) {
+	err := r.ParseForm()
+	if err != nil {
+		app.ClientError(w, http.StatusBadRequest)
+		return
+	}
+
+	snippet := app.reportedSnippet(w, r)
+	if snippet == nil {
+		return
+	}
+
+	form := &forms.ReportSnippet{
+		Reason: r.PostForm.Get("reason"),
+	}
+
+	if !form.Valid() {
+		app.RenderHTML(w, r, "report.page.html", &HTMLData{Form: form, Snippet: snippet})
+		return
+	}
+
+	session := app.Sessions.Load(r)
+	reporterID, err := session.GetInt("currentUserID")
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	err = app.Snippets.InsertReport(r.Context(), snippet.ID, reporterID, form.Reason)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	err = app.Audit(r, models.AuditSnippetReported, fmt.Sprintf("snippet %d", snippet.ID))
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	err = session.PutString(w, "flash", app.T(r, "Thanks for your report. A moderator will review it."))
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	http.Redirect(w, r, fmt.Sprintf("/snippet/%d", snippet.ID), http.StatusSeeOther)
+}
+
+func (app *App) ModerationQueue(w http.ResponseWriter, r *http.Request) {
+	reports, err := app.Snippets.OpenReports(r.Context())
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	resolved, err := app.Snippets.ResolvedReports(r.Context(), resolvedReportsShown)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	session := app.Sessions.Load(r)
+	flash, err := session.PopString(w, "flash")
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	app.RenderHTML(w, r, "moderation.page.html", &HTMLData{
+		Flash:           flash,
+		Reports:         reports,
+		ResolvedReports: resolved,
+	})
+}
+
+// ModerateReport applies a moderator's decision to a reported snippet: dismiss
+// the report, hide the snippet, or move it to the trash. The author can also
+// be suspended. The decision closes every open report against the snippet.
+func (app *App) ModerateReport(w http.ResponseWriter, r *http.Request) {
+	err := r.ParseForm()
+	if err != nil {
+		app.ClientError(w, http.StatusBadRequest)
+		return
+	}
+
+	id, err := strconv.Atoi(r.URL.Query().Get(":id"))
+	if err != nil || id < 1 {
+		app.NotFound(w)
+		return
+	}
+
+	report, err := app.Snippets.GetReport(r.Context(), id)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+	if report == nil {
+		app.NotFound(w)
+		return
+	}
+
+	session := app.Sessions.Load(r)
+	moderatorID, err := session.GetInt("currentUserID")
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	decision := r.PostForm.Get("decision")
+	suspend := r.PostForm.Get("suspend") == "on" && report.AuthorID != 0 && report.AuthorID != moderatorID
+
+	if report.Status != models.ReportOpen {
+		err = session.PutString(w, "flash", app.T(r, "Report #%d was already resolved.", report.ID))
+		if err != nil {
+			app.ServerError(w, err)
+			return
+		}
+		http.Redirect(w, r, "/moderation", http.StatusSeeOther)
+		return
+	}
+
+	// Moderators can only suspend users with fewer permissions than they
+	// have, so not other moderators or admins. This is checked before the
+	// decision is applied, so nothing changes if the suspension is refused.
+	var author *models.User
+	if suspend {
+		author, err = app.Users.GetUser(r.Context(), report.AuthorID)
+		if err != nil {
+			app.ServerError(w, err)
+			return
+		}
+
+		perms, err := app.CurrentPermissions(r)
+		if err != nil {
+			app.ServerError(w, err)
+			return
+		}
+
+		if author != nil && !models.Outranks(perms, author.Permissions) {
+			err = session.PutString(w, "flash", app.T(r, "You can't suspend %s.", author.Name))
+			if err != nil {
+				app.ServerError(w, err)
+				return
+			}
+			http.Redirect(w, r, "/moderation", http.StatusSeeOther)
+			return
+		}
+	}
+
+	switch decision {
+	case models.ReportDismissed:
+	case models.ReportHidden:
+		err = app.Snippets.SetHidden(r.Context(), report.SnippetID, true)
+	case models.ReportDeleted:
+		err = app.Snippets.DeleteSnippet(r.Context(), report.SnippetID, moderatorID)
+	default:
+		app.ClientError(w, http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	err = app.Snippets.ResolveReports(r.Context(), report.SnippetID, moderatorID, decision)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	err = app.Audit(r, models.AuditReportResolved, fmt.Sprintf("snippet %d: %s", report.SnippetID, decision))
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	msg := app.T(r, "Reports against snippet #%d were resolved as %s.", report.SnippetID, app.T(r, decision))
+	if author != nil {
+		err = app.Users.SetDisabled(r.Context(), author.ID, true)
+		if err != nil {
+			app.ServerError(w, err)
+			return
+		}
+
+		err = app.Audit(r, models.AuditUserDisabled, author.Email)
+		if err != nil {
+			app.ServerError(w, err)
+			return
+		}
+
+		msg += " " + app.T(r, "%s has been suspended.", author.Name)
+	}
+
+	err = session.PutString(w, "flash", msg)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	http.Redirect(w, r, "/moderation", http.StatusSeeOther)
+}
+
+// UnhideSnippet reverses a decision to hide a snippet.
+func (app *App) UnhideSnippet(w http.ResponseWriter, r *http.Request) {
+	err := r.ParseForm()
+	if err != nil {
+		app.ClientError(w, http.StatusBadRequest)
+		return
+	}
+
+	id, err := strconv.Atoi(r.PostForm.Get("id"))
+	if err != nil || id < 1 {
+		app.ClientError(w, http.StatusBadRequest)
+		return
+	}
+
+	err = app.Snippets.SetHidden(r.Context(), id, false)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	err = app.Audit(r, models.AuditSnippetUnhidden, fmt.Sprintf("snippet %d", id))
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	session := app.Sessions.Load(r)
+	err = session.PutString(w, "flash", app.T(r, "Snippet #%d is visible again.", id))
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	http.Redirect(w, r, "/moderation", http.StatusSeeOther)
+}
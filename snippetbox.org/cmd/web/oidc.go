@@ -0,0 +1,275 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"net/http"
+
+	"snippetbox.org/pkg/models"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"golang.org/x/oauth2"
+)
+
+// OIDC holds what's needed to log users in through an OpenID Connect identity
+// provider with the authorization code flow and PKCE.
+type OIDC struct {
+	Issuer      string
+	Config      oauth2.Config
+	Verifier    *oidc.IDTokenVerifier
+	AdminGroup  string
+	GroupsClaim string
+}
+
+// NewOIDC discovers the provider's endpoints from its issuer URL. If adminGroup
+// is set, membership of that group (read from the groupsClaim claim of the ID
+// token) decides whether a user has the admin role each time they log in.
+func NewOIDC(ctx context.Context, issuer, clientID, clientSecret, redirectURL, adminGroup, groupsClaim string) (*OIDC, error) {
+	provider, err := oidc.NewProvider(ctx, issuer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OIDC{
+		Issuer: issuer,
+		Config: oauth2.Config{
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+			RedirectURL:  redirectURL,
+			Endpoint:     provider.Endpoint(),
+			Scopes:       []string{oidc.ScopeOpenID, "profile", "email"},
+		},
+		Verifier:    provider.Verifier(&oidc.Config{ClientID: clientID}),
+		AdminGroup:  adminGroup,
+		GroupsClaim: groupsClaim,
+	}, nil
+}
+
+// isAdmin reports whether the claims put the user in the admin group.
+func (o *OIDC) isAdmin(claims map[string]interface{}) bool {
+	groups, _ := claims[o.GroupsClaim].([]interface{})
+	for _, g := range groups {
+		if g == o.AdminGroup {
+			return true
+		}
+	}
+	return false
+}
+
+func randomString() (string, error) {
+	b := make([]byte, 32)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func (app *App) LoginOIDC(w http.ResponseWriter, r *http.Request) {
+	if app.OIDC == nil {
+		app.NotFound(w)
+		return
+	}
+
+	// The state ties the callback to this browser, the nonce ties the ID token to
+	// this login, and the PKCE verifier ties the code exchange to this request.
+	// All three are kept in the session until the provider redirects back.
+	state, err := randomString()
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+	nonce, err := randomString()
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+	verifier := oauth2.GenerateVerifier()
+
+	session := app.Sessions.Load(r)
+	for key, val := range map[string]string{"oidcState": state, "oidcNonce": nonce, "oidcVerifier": verifier} {
+		err = session.PutString(w, key, val)
+		if err != nil {
+			app.ServerError(w, err)
+			return
+		}
+	}
+
+	url := app.OIDC.Config.AuthCodeURL(state, oidc.Nonce(nonce), oauth2.S256ChallengeOption(verifier))
+	http.Redirect(w, r, url, http.StatusFound)
+}
+
+func (app *App) CallbackOIDC(w http.ResponseWriter, r *http.Request) {
+	if app.OIDC == nil {
+		app.NotFound(w)
+		return
+	}
+
+	session := app.Sessions.Load(r)
+	state, err := session.PopString(w, "oidcState")
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+	nonce, err := session.PopString(w, "oidcNonce")
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+	verifier, err := session.PopString(w, "oidcVerifier")
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	query := r.URL.Query()
+	if state == "" || query.Get("state") != state || query.Get("error") != "" {
+		app.ClientError(w, http.StatusBadRequest)
+		return
+	}
+
+	token, err := app.OIDC.Config.Exchange(r.Context(), query.Get("code"), oauth2.VerifierOption(verifier))
+	if err != nil {
+		app.ClientError(w, http.StatusBadRequest)
+		return
+	}
+
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok {
+		app.ClientError(w, http.StatusBadRequest)
+		return
+	}
+
+	idToken, err := app.OIDC.Verifier.Verify(r.Context(), rawIDToken)
+	if err != nil || idToken.Nonce != nonce {
+		app.ClientError(w, http.StatusBadRequest)
+		return
+	}
+
+	var claims struct {
+		Name          string `json:"name"`
+		Email         string `json:"email"`
+		EmailVerified bool   `json:"email_verified"`
+	}
+	var allClaims map[string]interface{}
+	err = idToken.Claims(&claims)
+	if err == nil {
+		err = idToken.Claims(&allClaims)
+	}
+	if err != nil {
+		app.ClientError(w, http.StatusBadRequest)
+		return
+	}
+
+	// Accounts are matched and created by email, so refuse providers that don't
+	// vouch for one.
+	if claims.Email == "" || !claims.EmailVerified {
+		err = app.AuditAs(r, 0, models.AuditLoginFailed, claims.Email)
+		if err != nil {
+			app.ServerError(w, err)
+			return
+		}
+
+		msg := app.T(r, "Your identity provider didn't give a verified email address, so you can't log in with it.")
+		err = session.PutString(w, "flash", msg)
+		if err != nil {
+			app.ServerError(w, err)
+			return
+		}
+
+		http.Redirect(w, r, "/user/login", http.StatusSeeOther)
+		return
+	}
+
+	user, err := app.identityUser(r.Context(), idToken.Subject, claims.Name, claims.Email)
+	if err == models.ErrDuplicateEmail {
+		msg := app.T(r, "An account with your email already exists. Log in with your password to use it.")
+		err = session.PutString(w, "flash", msg)
+		if err != nil {
+			app.ServerError(w, err)
+			return
+		}
+
+		http.Redirect(w, r, "/user/login", http.StatusSeeOther)
+		return
+	} else if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	// If the provider is in charge of who's an admin, bring the user's role in
+	// line with their groups every time they log in. Admins who've left the group
+	// drop back to the default role. The change is the provider's, so it's
+	// audited with no actor.
+	if app.OIDC.AdminGroup != "" {
+		role := user.Role
+		if app.OIDC.isAdmin(allClaims) {
+			role = models.RoleAdmin
+		} else if user.Role == models.RoleAdmin {
+			role = models.DefaultRole
+		}
+
+		if role != user.Role {
+			err = app.Users.SetRole(r.Context(), user.ID, role)
+			if err != nil {
+				app.ServerError(w, err)
+				return
+			}
+
+			err = app.AuditAs(r, 0, models.AuditRoleChanged, roleChange(user.Email, user.Role, role))
+			if err != nil {
+				app.ServerError(w, err)
+				return
+			}
+
+			user, err = app.Users.GetUser(r.Context(), user.ID)
+			if err != nil {
+				app.ServerError(w, err)
+				return
+			}
+		}
+	}
+
+	// The provider stands in for the password, so users with two-factor
+	// authentication still need to enter a code.
+	if user.TOTPEnabled {
+		app.StartTwoFactor(w, r, user)
+		return
+	}
+
+	app.CompleteLogin(w, r, user)
+}
+
+// identityUser finds the user linked to the provider's subject. On a first
+// login it links an existing account with the same email, which the provider
+// must have verified, or creates a new one.
+func (app *App) identityUser(ctx context.Context, subject, name, email string) (*models.User, error) {
+	user, err := app.Users.GetUserByIdentity(ctx, app.OIDC.Issuer, subject)
+	if err != nil || user != nil {
+		return user, err
+	}
+
+	user, err = app.Users.GetUserByEmail(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+	if user != nil {
+		err = app.Users.LinkIdentity(ctx, user.ID, app.OIDC.Issuer, subject)
+		if err != nil {
+			return nil, err
+		}
+		return user, nil
+	}
+
+	if name == "" {
+		name = email
+	}
+
+	id, err := app.Users.InsertIdentityUser(ctx, name, email, app.OIDC.Issuer, subject)
+	if err != nil {
+		return nil, err
+	}
+
+	return app.Users.GetUser(ctx, id)
+}
@@ -0,0 +1,234 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"snippetbox.org/pkg/models"
+
+	jose "github.com/go-jose/go-jose/v4"
+)
+
+// testProvider is an OpenID Connect provider that issues ID tokens with
+// whatever claims the test sets, for any code.
+type testProvider struct {
+	*httptest.Server
+	key    *rsa.PrivateKey
+	claims map[string]interface{}
+	nonce  string
+}
+
+func newTestProvider(t *testing.T) *testProvider {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := &testProvider{key: key}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"issuer":                                p.URL,
+			"authorization_endpoint":                p.URL + "/authorize",
+			"token_endpoint":                        p.URL + "/token",
+			"jwks_uri":                              p.URL + "/keys",
+			"id_token_signing_alg_values_supported": []string{"RS256"},
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+			{Key: &key.PublicKey, KeyID: "test", Algorithm: "RS256", Use: "sig"},
+		}})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		idToken, err := p.idToken()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token": "access",
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+			"id_token":     idToken,
+		})
+	})
+	p.Server = httptest.NewServer(mux)
+	t.Cleanup(p.Close)
+
+	return p
+}
+
+// idToken signs an ID token with the provider's claims, for the nonce of the
+// login in progress.
+func (p *testProvider) idToken() (string, error) {
+	claims := map[string]interface{}{
+		"iss":   p.URL,
+		"aud":   "snippetbox",
+		"exp":   time.Now().Add(time.Hour).Unix(),
+		"iat":   time.Now().Unix(),
+		"nonce": p.nonce,
+	}
+	for k, v := range p.claims {
+		claims[k] = v
+	}
+
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	opts := (&jose.SignerOptions{}).WithType("JWT").WithHeader("kid", "test")
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.RS256, Key: p.key}, opts)
+	if err != nil {
+		return "", err
+	}
+
+	jws, err := signer.Sign(payload)
+	if err != nil {
+		return "", err
+	}
+	return jws.CompactSerialize()
+}
+
+// logInWith goes through the login redirect and the callback, and returns the
+// callback's response.
+func (p *testProvider) logInWith(t *testing.T, app *App, client *testClient) *httptest.ResponseRecorder {
+	t.Helper()
+
+	rr := client.do(app.LoginOIDC, httptest.NewRequest(http.MethodGet, "/user/login/oidc", nil))
+	location, err := url.Parse(rr.Header().Get("Location"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.nonce = location.Query().Get("nonce")
+
+	callback := "/user/login/oidc/callback?code=test&state=" + url.QueryEscape(location.Query().Get("state"))
+	return client.do(app.CallbackOIDC, httptest.NewRequest(http.MethodGet, callback, nil))
+}
+
+func TestCallbackOIDC(t *testing.T) {
+	tests := []struct {
+		name         string
+		claims       map[string]interface{}
+		totp         bool
+		wantLocation string
+		wantLoggedIn bool
+		wantRole     string
+	}{
+		{
+			name:         "Verified email",
+			claims:       map[string]interface{}{"sub": "1", "email": "alice@example.com", "email_verified": true},
+			wantLocation: "/snippet/new",
+			wantLoggedIn: true,
+			wantRole:     models.DefaultRole,
+		},
+		{
+			name:         "Unverified email",
+			claims:       map[string]interface{}{"sub": "1", "email": "alice@example.com", "email_verified": false},
+			wantLocation: "/user/login",
+		},
+		{
+			name:         "No email_verified claim",
+			claims:       map[string]interface{}{"sub": "1", "email": "alice@example.com"},
+			wantLocation: "/user/login",
+		},
+		{
+			name:         "No email",
+			claims:       map[string]interface{}{"sub": "1", "email_verified": true},
+			wantLocation: "/user/login",
+		},
+		{
+			name:         "Admin group",
+			claims:       map[string]interface{}{"sub": "1", "email": "alice@example.com", "email_verified": true, "groups": []string{"admins"}},
+			wantLocation: "/snippet/new",
+			wantLoggedIn: true,
+			wantRole:     models.RoleAdmin,
+		},
+		{
+			name:         "Two-factor",
+			claims:       map[string]interface{}{"sub": "1", "email": "alice@example.com", "email_verified": true},
+			totp:         true,
+			wantLocation: "/user/login/2fa",
+			wantRole:     models.DefaultRole,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			app, db := newTestApp(t)
+			provider := newTestProvider(t)
+
+			var err error
+			app.OIDC, err = NewOIDC(ctx, provider.URL, "snippetbox", "secret", "https://snippetbox.test/user/login/oidc/callback", "admins", "groups")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tt.totp {
+				err := db.InsertUser(ctx, "Alice", "alice@example.com", "correct horse")
+				if err != nil {
+					t.Fatal(err)
+				}
+				_, err = db.EnableTOTP(ctx, 1, "JBSWY3DPEHPK3PXP")
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			provider.claims = tt.claims
+			client := newTestClient(app)
+			rr := provider.logInWith(t, app, client)
+			if rr.Code != http.StatusSeeOther || rr.Header().Get("Location") != tt.wantLocation {
+				t.Errorf("got status %d to %q; want 303 to %q", rr.Code, rr.Header().Get("Location"), tt.wantLocation)
+			}
+
+			var loggedIn bool
+			client.do(func(w http.ResponseWriter, r *http.Request) {
+				loggedIn, _ = app.LoggedIn(r)
+			}, httptest.NewRequest(http.MethodGet, "/", nil))
+			if loggedIn != tt.wantLoggedIn {
+				t.Errorf("got logged in %v; want %v", loggedIn, tt.wantLoggedIn)
+			}
+
+			user, err := db.GetUserByEmail(ctx, "alice@example.com")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.wantRole == "" {
+				if user != nil {
+					t.Errorf("created user %+v", user)
+				}
+				return
+			}
+			if user == nil || user.Role != tt.wantRole {
+				t.Fatalf("got user %+v; want role %s", user, tt.wantRole)
+			}
+
+			entries, _, err := db.AuditEntries(ctx, &models.AuditFilter{Action: models.AuditRoleChanged}, 0, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.wantRole == models.RoleAdmin {
+				want := roleChange(user.Email, models.DefaultRole, models.RoleAdmin)
+				if len(entries) != 1 || entries[0].Target != want {
+					t.Errorf("got role change audit entries %+v; want one for %q", entries, want)
+				}
+			} else if len(entries) != 0 {
+				t.Errorf("got role change audit entries %+v", entries)
+			}
+		})
+	}
+}
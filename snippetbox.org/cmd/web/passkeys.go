@@ -0,0 +1,345 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"snippetbox.org/pkg/models"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+)
+
+// passkeyUser adapts a user and their stored passkeys to the webauthn.User
+// interface. The user handle is the user's ID, which is how a discoverable
+// login finds its way back to the account.
+type passkeyUser struct {
+	*models.User
+	passkeys []*models.Passkey
+}
+
+func (u *passkeyUser) WebAuthnID() []byte {
+	return []byte(strconv.Itoa(u.ID))
+}
+
+func (u *passkeyUser) WebAuthnName() string {
+	return u.Email
+}
+
+func (u *passkeyUser) WebAuthnDisplayName() string {
+	return u.Name
+}
+
+func (u *passkeyUser) WebAuthnIcon() string {
+	return ""
+}
+
+func (u *passkeyUser) WebAuthnCredentials() []webauthn.Credential {
+	creds := make([]webauthn.Credential, len(u.passkeys))
+	for i, p := range u.passkeys {
+		creds[i] = p.Credential
+	}
+	return creds
+}
+
+func (app *App) loadPasskeyUser(ctx context.Context, user *models.User) (*passkeyUser, error) {
+	passkeys, err := app.Users.UserPasskeys(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &passkeyUser{User: user, passkeys: passkeys}, nil
+}
+
+// How long a user has to answer a WebAuthn challenge.
+const ceremonyLifetime = 5 * time.Minute
+
+// putCeremony and popCeremony keep the WebAuthn session data, which holds the
+// challenge, in the database between the begin and finish requests, with only
+// the challenge in the scs session to say which is this browser's. The
+// session is a cookie, which could be sent again, but the database forgets
+// the challenge once it's popped, so each one can only be answered once.
+func (app *App) putCeremony(w http.ResponseWriter, r *http.Request, key string, data *webauthn.SessionData) error {
+	err := app.Users.InsertCeremony(r.Context(), data, ceremonyLifetime)
+	if err != nil {
+		return err
+	}
+
+	return app.Sessions.Load(r).PutString(w, key, data.Challenge)
+}
+
+func (app *App) popCeremony(w http.ResponseWriter, r *http.Request, key string) (*webauthn.SessionData, error) {
+	challenge, err := app.Sessions.Load(r).PopString(w, key)
+	if err != nil || challenge == "" {
+		return nil, err
+	}
+
+	return app.Users.TakeCeremony(r.Context(), challenge)
+}
+
+func (app *App) Passkeys(w http.ResponseWriter, r *http.Request) {
+	user, err := app.CurrentUser(r)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	passkeys, err := app.Users.UserPasskeys(r.Context(), user.ID)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	session := app.Sessions.Load(r)
+	flash, err := session.PopString(w, "flash")
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	app.RenderHTML(w, r, "passkeys.page.html", &HTMLData{
+		Flash:    flash,
+		Passkeys: passkeys,
+	})
+}
+
+func (app *App) BeginPasskeyRegistration(w http.ResponseWriter, r *http.Request) {
+	user, err := app.CurrentUser(r)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	pu, err := app.loadPasskeyUser(r.Context(), user)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	// Passkeys have to be discoverable so that the login page doesn't need to know
+	// who the user is, and an authenticator shouldn't be registered twice.
+	exclusions := make([]protocol.CredentialDescriptor, len(pu.passkeys))
+	for i, p := range pu.passkeys {
+		exclusions[i] = p.Credential.Descriptor()
+	}
+
+	options, data, err := app.WebAuthn.BeginRegistration(pu,
+		webauthn.WithResidentKeyRequirement(protocol.ResidentKeyRequirementRequired),
+		webauthn.WithExclusions(exclusions),
+	)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	err = app.putCeremony(w, r, "webauthnRegistration", data)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	app.RenderJSON(w, http.StatusOK, options)
+}
+
+func (app *App) FinishPasskeyRegistration(w http.ResponseWriter, r *http.Request) {
+	user, err := app.CurrentUser(r)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	data, err := app.popCeremony(w, r, "webauthnRegistration")
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+	if data == nil {
+		app.ClientError(w, http.StatusBadRequest)
+		return
+	}
+
+	pu, err := app.loadPasskeyUser(r.Context(), user)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	cred, err := app.WebAuthn.FinishRegistration(pu, *data, r)
+	if err != nil {
+		app.ClientError(w, http.StatusBadRequest)
+		return
+	}
+
+	name := strings.TrimSpace(r.URL.Query().Get("name"))
+	if name == "" || utf8.RuneCountInString(name) > 50 {
+		name = "Passkey"
+	}
+
+	err = app.Users.InsertPasskey(r.Context(), user.ID, name, cred)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	err = app.Sessions.Load(r).PutString(w, "flash", app.T(r, "Your passkey was added."))
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (app *App) DeletePasskey(w http.ResponseWriter, r *http.Request) {
+	err := r.ParseForm()
+	if err != nil {
+		app.ClientError(w, http.StatusBadRequest)
+		return
+	}
+
+	id, err := strconv.Atoi(r.PostForm.Get("id"))
+	if err != nil {
+		app.ClientError(w, http.StatusBadRequest)
+		return
+	}
+
+	user, err := app.CurrentUser(r)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	err = app.Users.DeletePasskey(r.Context(), user.ID, id)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	err = app.Sessions.Load(r).PutString(w, "flash", app.T(r, "Your passkey was removed."))
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	http.Redirect(w, r, "/user/passkeys", http.StatusSeeOther)
+}
+
+func (app *App) BeginPasskeyLogin(w http.ResponseWriter, r *http.Request) {
+	options, data, err := app.WebAuthn.BeginDiscoverableLogin()
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	err = app.putCeremony(w, r, "webauthnLogin", data)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	app.RenderJSON(w, http.StatusOK, options)
+}
+
+var errUnknownPasskeyUser = errors.New("passkey belongs to an unknown user")
+
+func (app *App) FinishPasskeyLogin(w http.ResponseWriter, r *http.Request) {
+	// Failed passkey logins are throttled by the client's IP like passwords.
+	wait, err := app.IPThrottle.Wait(r.Context(), ipKey(clientIP(r)))
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+	if wait > 0 {
+		w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+		app.ClientError(w, http.StatusTooManyRequests)
+		return
+	}
+
+	data, err := app.popCeremony(w, r, "webauthnLogin")
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+	if data == nil {
+		app.ClientError(w, http.StatusBadRequest)
+		return
+	}
+
+	// The authenticator tells us which account the passkey belongs to via the user
+	// handle, so look the user up from that.
+	var pu *passkeyUser
+	cred, err := app.WebAuthn.FinishDiscoverableLogin(func(rawID, userHandle []byte) (webauthn.User, error) {
+		id, err := strconv.Atoi(string(userHandle))
+		if err != nil {
+			return nil, errUnknownPasskeyUser
+		}
+
+		user, err := app.Users.GetUser(r.Context(), id)
+		if err != nil {
+			return nil, err
+		}
+		if user == nil {
+			return nil, errUnknownPasskeyUser
+		}
+
+		pu, err = app.loadPasskeyUser(r.Context(), user)
+		return pu, err
+	}, *data, r)
+	if err != nil {
+		// The failure counts against the account too if the passkey said
+		// whose it was.
+		email := ""
+		if pu != nil {
+			email = pu.Email
+		}
+
+		err = app.loginFailed(r, email)
+		if err != nil {
+			app.ServerError(w, err)
+			return
+		}
+
+		app.Metrics.LoginsFailed.Inc()
+
+		err = app.AuditAs(r, 0, models.AuditLoginFailed, email)
+		if err != nil {
+			app.ServerError(w, err)
+			return
+		}
+
+		app.ClientError(w, http.StatusUnauthorized)
+		return
+	}
+
+	// A signature count that didn't go up since the last login means there are
+	// probably two copies of the passkey, so refuse it and leave the stored
+	// count alone.
+	if cred.Authenticator.CloneWarning {
+		app.Logger.Warn("passkey may have been cloned", "user_id", pu.ID, "request_id", requestID(w))
+
+		err = app.AuditAs(r, 0, models.AuditPasskeyCloned, pu.Email)
+		if err != nil {
+			app.ServerError(w, err)
+			return
+		}
+
+		app.ClientError(w, http.StatusUnauthorized)
+		return
+	}
+
+	err = app.Users.UpdatePasskey(r.Context(), cred)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	// A passkey proves possession of a device and the user's presence, so it
+	// stands in for both the password and the second factor.
+	app.CompleteLogin(w, r, pu.User)
+}
@@ -0,0 +1,307 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"snippetbox.org/pkg/models"
+	"snippetbox.org/pkg/throttle"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/go-webauthn/webauthn/webauthn"
+)
+
+var b64 = base64.RawURLEncoding
+
+// testAuthenticator is a software authenticator holding a single ES256 passkey.
+type testAuthenticator struct {
+	key    *ecdsa.PrivateKey
+	credID []byte
+	count  uint32
+}
+
+func newTestAuthenticator(t *testing.T) *testAuthenticator {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	credID := make([]byte, 32)
+	_, err = rand.Read(credID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &testAuthenticator{key: key, credID: credID}
+}
+
+// authData builds the authenticator data for the test RP ID, with the flags,
+// the next signature count, and any attested credential data appended.
+func (a *testAuthenticator) authData(flags byte, attested []byte) []byte {
+	rpIDHash := sha256.Sum256([]byte("snippetbox.test"))
+	a.count++
+
+	var b bytes.Buffer
+	b.Write(rpIDHash[:])
+	b.WriteByte(flags)
+	binary.Write(&b, binary.BigEndian, a.count)
+	b.Write(attested)
+	return b.Bytes()
+}
+
+// clientData is the JSON a browser would pass to the authenticator for a
+// ceremony with the challenge from the options.
+func clientData(t *testing.T, typ string, options []byte) []byte {
+	t.Helper()
+
+	var o struct {
+		PublicKey struct {
+			Challenge string `json:"challenge"`
+		} `json:"publicKey"`
+	}
+	err := json.Unmarshal(options, &o)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	j, err := json.Marshal(map[string]string{
+		"type":      typ,
+		"challenge": o.PublicKey.Challenge,
+		"origin":    "https://snippetbox.test",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return j
+}
+
+// register answers registration options with a credential using "none"
+// attestation.
+func (a *testAuthenticator) register(t *testing.T, options []byte) []byte {
+	t.Helper()
+
+	coseKey, err := cbor.Marshal(map[int]interface{}{
+		1:  2,  // kty: EC2
+		3:  -7, // alg: ES256
+		-1: 1,  // crv: P-256
+		-2: a.key.X.FillBytes(make([]byte, 32)),
+		-3: a.key.Y.FillBytes(make([]byte, 32)),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var attested bytes.Buffer
+	attested.Write(make([]byte, 16)) // AAGUID
+	binary.Write(&attested, binary.BigEndian, uint16(len(a.credID)))
+	attested.Write(a.credID)
+	attested.Write(coseKey)
+
+	// User present, user verified and attested credential data included.
+	attestation, err := cbor.Marshal(map[string]interface{}{
+		"fmt":      "none",
+		"attStmt":  map[string]interface{}{},
+		"authData": a.authData(0x45, attested.Bytes()),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return a.credential(t, map[string]string{
+		"clientDataJSON":    b64.EncodeToString(clientData(t, "webauthn.create", options)),
+		"attestationObject": b64.EncodeToString(attestation),
+	})
+}
+
+// assert answers login options with a signed assertion for the user.
+func (a *testAuthenticator) assert(t *testing.T, options []byte, userID int) []byte {
+	t.Helper()
+
+	cd := clientData(t, "webauthn.get", options)
+	authData := a.authData(0x05, nil) // User present and user verified.
+
+	hash := sha256.Sum256(cd)
+	digest := sha256.Sum256(append(authData, hash[:]...))
+	sig, err := ecdsa.SignASN1(rand.Reader, a.key, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return a.credential(t, map[string]string{
+		"clientDataJSON":    b64.EncodeToString(cd),
+		"authenticatorData": b64.EncodeToString(authData),
+		"signature":         b64.EncodeToString(sig),
+		"userHandle":        b64.EncodeToString([]byte(strconv.Itoa(userID))),
+	})
+}
+
+func (a *testAuthenticator) credential(t *testing.T, response map[string]string) []byte {
+	t.Helper()
+
+	j, err := json.Marshal(map[string]interface{}{
+		"id":       b64.EncodeToString(a.credID),
+		"rawId":    b64.EncodeToString(a.credID),
+		"type":     "public-key",
+		"response": response,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return j
+}
+
+// jsonPost returns a POST request with a JSON body, as the passkey script sends.
+func jsonPost(path string, body []byte) *http.Request {
+	r := httptest.NewRequest(http.MethodPost, path, bytes.NewReader(body))
+	r.Header.Set("Content-Type", "application/json")
+	return r
+}
+
+func TestPasskeyLogin(t *testing.T) {
+	tests := []struct {
+		name       string
+		replay     bool
+		tamper     bool
+		clone      bool
+		wantStatus int
+		wantFailed bool
+	}{
+		{name: "Valid assertion", wantStatus: http.StatusSeeOther},
+		{name: "Replayed challenge", replay: true, wantStatus: http.StatusBadRequest},
+		{name: "Bad signature", tamper: true, wantStatus: http.StatusUnauthorized, wantFailed: true},
+		{name: "Cloned authenticator", clone: true, wantStatus: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			app, db := newTestApp(t)
+
+			var err error
+			app.WebAuthn, err = webauthn.New(&webauthn.Config{
+				RPDisplayName: "Snippetbox",
+				RPID:          "snippetbox.test",
+				RPOrigins:     []string{"https://snippetbox.test"},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			throttles := throttle.NewMemStore(time.Hour)
+			app.IPThrottle = throttle.New(throttles)
+
+			err = db.InsertUser(ctx, "Alice", "alice@example.com", "correct horse")
+			if err != nil {
+				t.Fatal(err)
+			}
+			user, err := db.GetUser(ctx, 1)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// Register a passkey while logged in.
+			auth := newTestAuthenticator(t)
+			owner := newTestClient(app)
+			err = owner.logIn(user)
+			if err != nil {
+				t.Fatal(err)
+			}
+			rr := owner.do(app.BeginPasskeyRegistration, jsonPost("/user/passkeys/register/begin", nil))
+			if rr.Code != http.StatusOK {
+				t.Fatalf("got status %d from registration begin", rr.Code)
+			}
+			rr = owner.do(app.FinishPasskeyRegistration, jsonPost("/user/passkeys/register/finish", auth.register(t, rr.Body.Bytes())))
+			if rr.Code != http.StatusNoContent {
+				t.Fatalf("got status %d from registration finish: %s", rr.Code, rr.Body)
+			}
+
+			// Then log in with it from another browser.
+			client := newTestClient(app)
+			rr = client.do(app.BeginPasskeyLogin, jsonPost("/user/login/passkey/begin", nil))
+			if rr.Code != http.StatusOK {
+				t.Fatalf("got status %d from login begin", rr.Code)
+			}
+			options := rr.Body.Bytes()
+
+			// The session is a cookie, so sending the same cookie back again
+			// must not let the challenge be answered twice.
+			cookies := make(map[string]*http.Cookie)
+			for k, v := range client.cookies {
+				cookies[k] = v
+			}
+
+			if tt.tamper {
+				auth.key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+			if tt.clone {
+				// A copy of the passkey whose count is behind the original's.
+				auth.count = 0
+			}
+			assertion := auth.assert(t, options, user.ID)
+			if tt.replay {
+				rr = client.do(app.FinishPasskeyLogin, jsonPost("/user/login/passkey/finish", assertion))
+				if rr.Code != http.StatusSeeOther {
+					t.Fatalf("got status %d from the first login finish: %s", rr.Code, rr.Body)
+				}
+				client = newTestClient(app)
+				client.cookies = cookies
+				assertion = auth.assert(t, options, user.ID)
+			}
+
+			rr = client.do(app.FinishPasskeyLogin, jsonPost("/user/login/passkey/finish", assertion))
+			if rr.Code != tt.wantStatus {
+				t.Fatalf("got status %d; want %d: %s", rr.Code, tt.wantStatus, rr.Body)
+			}
+
+			var loggedIn bool
+			client.do(func(w http.ResponseWriter, r *http.Request) {
+				loggedIn, _ = app.LoggedIn(r)
+			}, httptest.NewRequest(http.MethodGet, "/", nil))
+			if want := tt.wantStatus == http.StatusSeeOther; loggedIn != want {
+				t.Errorf("got logged in %v; want %v", loggedIn, want)
+			}
+
+			_, found, err := throttles.Find(ctx, ipKey("192.0.2.1"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if found != tt.wantFailed {
+				t.Errorf("got IP throttle entry %v; want %v", found, tt.wantFailed)
+			}
+
+			entries, _, err := db.AuditEntries(ctx, &models.AuditFilter{Action: models.AuditLoginFailed}, 0, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.wantFailed && (len(entries) != 1 || entries[0].Target != user.Email) {
+				t.Errorf("got failed login audit entries %+v; want one for %s", entries, user.Email)
+			} else if !tt.wantFailed && len(entries) != 0 {
+				t.Errorf("got failed login audit entries %+v", entries)
+			}
+
+			_, n, err := db.AuditEntries(ctx, &models.AuditFilter{Action: models.AuditPasskeyCloned}, 0, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if (n == 1) != tt.clone {
+				t.Errorf("got %d cloned passkey audit entries", n)
+			}
+		})
+	}
+}
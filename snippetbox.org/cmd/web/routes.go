@@ -2,31 +2,104 @@ package main
 
 import (
 	"net/http"
-	"github.com/bmizerany/pat"
+	"strings"
+
+	"snippetbox.org/pkg/models"
 )
 
 func (app *App) Routes() http.Handler {
 	// Wrap all of our web page route with the NoSurf middleware.
-	mux := pat.New()
+	mux := newRouteMux(app.Metrics)
 	mux.Get("/", NoSurf(app.Home))
-	mux.Get("/snippet/new", app.RequireLogin(NoSurf(app.NewSnippet)))
-	mux.Post("/snippet/new", app.RequireLogin(NoSurf(app.CreateSnippet)))
-	mux.Get("/snippet/delete", app.RequireAdmin(NoSurf(app.EraseSnippet)))
-	mux.Post("/snippet/delete", app.RequireAdmin(NoSurf(app.DeleteSnippet)))
+	mux.Get("/healthz", http.HandlerFunc(app.Healthz))
+	mux.Get("/readyz", http.HandlerFunc(app.Readyz))
+	mux.Get("/snippet/new", app.RequirePermission(models.PermCreateSnippets)(NoSurf(app.NewSnippet)))
+	mux.Post("/snippet/new", app.RateLimit(app.WriteLimit)(app.RequirePermission(models.PermCreateSnippets)(NoSurf(app.CreateSnippet))))
+	mux.Get("/snippet/delete", app.RequirePermission(models.PermDeleteSnippets)(NoSurf(app.EraseSnippet)))
+	mux.Post("/snippet/delete", app.RateLimit(app.WriteLimit)(app.RequireLogin(NoSurf(app.DeleteSnippet))))
+	mux.Get("/snippet/trash", app.RequireLogin(NoSurf(app.Trash)))
+	mux.Post("/snippet/restore", app.RequireLogin(NoSurf(app.RestoreSnippet)))
+	mux.Get("/snippet/:id/report", app.RequirePermission(models.PermViewSnippets)(NoSurf(app.ReportSnippetForm)))
+	mux.Post("/snippet/:id/report", app.RateLimit(app.WriteLimit)(app.RequirePermission(models.PermViewSnippets)(NoSurf(app.ReportSnippet))))
 	//mux.Get("/snippet/:id", NoSurf(app.ShowSnippet))
-	mux.Get("/snippet/:id", app.RequireLogin(NoSurf(app.ShowSnippet)))
+	mux.Get("/snippet/:id", app.RequirePermission(models.PermViewSnippets)(NoSurf(app.ShowSnippet)))
+
+	mux.Get("/moderation", app.RequirePermission(models.PermModerateSnippets)(NoSurf(app.ModerationQueue)))
+	mux.Post("/moderation/unhide", app.RequirePermission(models.PermModerateSnippets)(NoSurf(app.UnhideSnippet)))
+	mux.Post("/moderation/:id", app.RequirePermission(models.PermModerateSnippets)(NoSurf(app.ModerateReport)))
 
 	mux.Get("/user/signup", NoSurf(app.SignupUser))
-	mux.Post("/user/signup", NoSurf(app.CreateUser))
+	mux.Post("/user/signup", app.RateLimit(app.AuthLimit)(NoSurf(app.CreateUser)))
 	mux.Get("/user/login", NoSurf(app.LoginUser))
-	mux.Post("/user/login", NoSurf(app.VerifyUser))
+	mux.Post("/user/login", app.RateLimit(app.AuthLimit)(NoSurf(app.VerifyUser)))
+	mux.Get("/user/login/2fa", NoSurf(app.LoginTwoFactor))
+	mux.Post("/user/login/2fa", app.RateLimit(app.AuthLimit)(NoSurf(app.VerifyTwoFactor)))
+	mux.Post("/user/login/passkey/begin", app.RateLimit(app.AuthLimit)(NoSurf(app.BeginPasskeyLogin)))
+	mux.Post("/user/login/passkey/finish", app.RateLimit(app.AuthLimit)(NoSurf(app.FinishPasskeyLogin)))
+	mux.Get("/user/login/oidc", NoSurf(app.LoginOIDC))
+	mux.Get("/user/login/oidc/callback", NoSurf(app.CallbackOIDC))
 	mux.Post("/user/logout", app.RequireLogin(NoSurf(app.LogoutUser)))
+	mux.Get("/user/password/forgot", NoSurf(app.ForgotPassword))
+	mux.Post("/user/password/forgot", app.RateLimit(app.AuthLimit)(NoSurf(app.SendPasswordReset)))
+	mux.Get("/user/password/reset/:token", NoSurf(app.ResetPassword))
+	mux.Post("/user/password/reset/:token", app.RateLimit(app.AuthLimit)(NoSurf(app.UpdatePassword)))
+	mux.Get("/user/2fa", app.RequireLogin(NoSurf(app.TwoFactor)))
+	mux.Post("/user/2fa/enable", app.RateLimit(app.AuthLimit)(app.RequireLogin(NoSurf(app.EnableTwoFactor))))
+	mux.Post("/user/2fa/disable", app.RateLimit(app.AuthLimit)(app.RequireLogin(NoSurf(app.DisableTwoFactor))))
+	mux.Post("/user/2fa/recovery", app.RateLimit(app.AuthLimit)(app.RequireLogin(NoSurf(app.RegenerateRecoveryCodes))))
+	mux.Get("/user/passkeys", app.RequireLogin(NoSurf(app.Passkeys)))
+	mux.Post("/user/passkeys/register/begin", app.RequireLogin(NoSurf(app.BeginPasskeyRegistration)))
+	mux.Post("/user/passkeys/register/finish", app.RequireLogin(NoSurf(app.FinishPasskeyRegistration)))
+	mux.Post("/user/passkeys/delete", app.RequireLogin(NoSurf(app.DeletePasskey)))
+	mux.Post("/user/language", NoSurf(app.SetLanguage))
+	mux.Post("/user/timezone", NoSurf(app.SetBrowserTimezone))
+	mux.Get("/user/preferences", app.RequireLogin(NoSurf(app.Preferences)))
+	mux.Post("/user/preferences", app.RequireLogin(NoSurf(app.UpdatePreferences)))
+
+	mux.Get("/admin/signup", app.RequirePermission(models.PermManageUsers)(NoSurf(app.SignupAdmin)))
+	mux.Post("/admin/signup", app.RequirePermission(models.PermManageUsers)(NoSurf(app.CreateAdmin)))
+	mux.Get("/admin/settings", app.RequirePermission(models.PermManageSettings)(NoSurf(app.EditSettings)))
+	mux.Post("/admin/settings", app.RequirePermission(models.PermManageSettings)(NoSurf(app.UpdateSettings)))
+	mux.Get("/admin/lockouts", app.RequirePermission(models.PermManageUsers)(NoSurf(app.Lockouts)))
+	mux.Post("/admin/lockouts/unlock", app.RequirePermission(models.PermManageUsers)(NoSurf(app.Unlock)))
+	mux.Get("/admin/audit", app.RequirePermission(models.PermViewAudit)(NoSurf(app.AuditLog)))
+	mux.Get("/admin/audit/export", app.RequirePermission(models.PermViewAudit)(NoSurf(app.ExportAuditLog)))
+	mux.Get("/admin/users", app.RequirePermission(models.PermManageUsers)(NoSurf(app.AdminUsers)))
+	mux.Get("/admin/users/:id", app.RequirePermission(models.PermManageUsers)(NoSurf(app.AdminShowUser)))
+	mux.Post("/admin/users/:id/role", app.RequirePermission(models.PermManageUsers)(NoSurf(app.AdminSetRole)))
+	mux.Post("/admin/users/:id/disable", app.RequirePermission(models.PermManageUsers)(NoSurf(app.AdminDisableUser)))
+	mux.Post("/admin/users/:id/enable", app.RequirePermission(models.PermManageUsers)(NoSurf(app.AdminEnableUser)))
+	mux.Post("/admin/users/:id/reset", app.RequirePermission(models.PermManageUsers)(NoSurf(app.AdminResetPassword)))
+	mux.Post("/admin/users/:id/logout", app.RequirePermission(models.PermManageUsers)(NoSurf(app.AdminLogoutUser)))
 
-	mux.Get("/admin/signup", app.RequireAdmin(NoSurf(app.SignupAdmin)))
-	mux.Post("/admin/signup", app.RequireAdmin(NoSurf(app.CreateAdmin)))
+	// Metrics are served here when there's a token to protect them with, and on
+	// their own listener when one is configured.
+	if app.MetricsToken != "" {
+		mux.Get("/metrics", app.Metrics.Handler(app.MetricsToken))
+	}
 
-	fileServer := http.FileServer(http.Dir(app.StaticDir))
+	mux.Get("/static/css/highlight.css", http.HandlerFunc(app.HighlightCSS))
+	fileServer := http.FileServer(http.FS(app.Static))
 	mux.Get("/static/", http.StripPrefix("/static", fileServer))
 
-	return LogRequest(SecureHeaders(mux))
+	// Share one loaded session across everything that handles a request, so that
+	// changes made by one helper aren't lost when another loads the session again.
+	// The site-wide rate limit sits inside it so it can tell who's logged in.
+	limited := app.Sessions.Use(app.Authenticate(app.RateLimit(app.GlobalLimit)(mux)))
+
+	// Health checks and static files need neither, and shouldn't be refused or
+	// cost a trip to the rate limit store.
+	return app.LogRequest(app.SecureHeaders(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if unlimitedPath(r.URL.Path) {
+			mux.ServeHTTP(w, r)
+			return
+		}
+		limited.ServeHTTP(w, r)
+	})))
+}
+
+// unlimitedPath reports whether requests for the path skip sessions and the
+// site-wide rate limit.
+func unlimitedPath(path string) bool {
+	return path == "/healthz" || path == "/readyz" || strings.HasPrefix(path, "/static/")
 }
@@ -1,19 +1,111 @@
 package main
 
 import (
+	"context"
 	"crypto/tls"
-	"log"
+	"errors"
+	"log/slog"
+	"net"
 	"net/http"
+	"os"
+	"strconv"
 	"time"
+
+	"golang.org/x/crypto/acme"
 )
 
-func (app *App) RunServer() {
+// The first file descriptor systemd passes to a socket activated process.
+const listenFDsStart = 3
+
+// listen returns a listener for addr. When systemd started the process through
+// socket activation, it uses the socket systemd passed in instead. systemd
+// keeps that socket open and queues connections on it while the service
+// restarts, so a new binary can take over without refusing any.
+func listen(addr string) (net.Listener, error) {
+	if os.Getenv("LISTEN_PID") == strconv.Itoa(os.Getpid()) {
+		n, _ := strconv.Atoi(os.Getenv("LISTEN_FDS"))
+
+		// Don't pass the sockets on to any child processes.
+		os.Unsetenv("LISTEN_PID")
+		os.Unsetenv("LISTEN_FDS")
+		os.Unsetenv("LISTEN_FDNAMES")
+
+		if n > 0 {
+			f := os.NewFile(listenFDsStart, "systemd-socket")
+			defer f.Close()
+			return net.FileListener(f)
+		}
+	}
+
+	return net.Listen("tcp", addr)
+}
+
+// serve runs srv on ln until ctx is cancelled, and then shuts it down: it stops
+// accepting connections and gives in-flight requests up to the shutdown
+// timeout to finish. It serves TLS if the server has a TLS config.
+func (app *App) serve(ctx context.Context, srv *http.Server, ln net.Listener) error {
+	errc := make(chan error, 1)
+	go func() {
+		if srv.TLSConfig != nil {
+			errc <- srv.ServeTLS(ln, "", "")
+		} else {
+			errc <- srv.Serve(ln)
+		}
+	}()
+
+	select {
+	case err := <-errc:
+		return err
+	case <-ctx.Done():
+	}
+
+	app.Logger.Info("shutting down server", "addr", srv.Addr, "timeout", app.ShutdownTimeout.String())
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), app.ShutdownTimeout)
+	defer cancel()
+
+	err := srv.Shutdown(shutdownCtx)
+	if err != nil {
+		return err
+	}
+
+	// Serve returns ErrServerClosed as soon as Shutdown is called.
+	if err := <-errc; !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// RunServer serves the application until ctx is cancelled, then shuts down
+// gracefully. If there's an HTTP address, it also serves plain HTTP there,
+// redirecting to HTTPS and answering ACME challenges.
+func (app *App) RunServer(ctx context.Context) error {
 	tlsConfig := &tls.Config{
-		PreferServerCipherSuites: true,
+		MinVersion:   app.TLSMinVersion,
+		CipherSuites: app.TLSCipherSuites,
 		CurvePreferences:
 		[]tls.CurveID{tls.X25519, tls.CurveP256},
 	}
 
+	var httpHandler http.Handler = http.HandlerFunc(app.redirectToHTTPS)
+	if app.ACME != nil {
+		m, err := app.ACME.Manager()
+		if err != nil {
+			return err
+		}
+
+		tlsConfig.GetCertificate = m.GetCertificate
+		tlsConfig.NextProtos = []string{"h2", "http/1.1", acme.ALPNProto}
+		httpHandler = m.HTTPHandler(httpHandler)
+	} else {
+		certs, err := newCertReloader(app.TLSCert, app.TLSKey, app.Logger)
+		if err != nil {
+			return err
+		}
+
+		tlsConfig.GetCertificate = certs.GetCertificate
+	}
+
 	// Add Idle, Read and Write timeouts to the server.
 	srv := &http.Server{
 		Addr:         app.Addr,
@@ -22,9 +114,90 @@ func (app *App) RunServer() {
 		IdleTimeout:  time.Minute,
 		ReadTimeout:  5 * time.Second,
 		WriteTimeout: 10 * time.Second,
+		ErrorLog:     slog.NewLogLogger(app.Logger.Handler(), slog.LevelError),
+	}
+
+	ln, err := listen(app.Addr)
+	if err != nil {
+		return err
+	}
+
+	if app.HTTPAddr == "" {
+		app.Logger.Info("starting server", "addr", ln.Addr().String())
+		return app.serve(ctx, srv, ln)
 	}
 
-	log.Printf("Starting server on %s", app.Addr)
-	err := srv.ListenAndServeTLS(app.TLSCert, app.TLSKey)
-	log.Fatal(err)
+	httpSrv := &http.Server{
+		Addr:         app.HTTPAddr,
+		Handler:      httpHandler,
+		ReadTimeout:  5 * time.Second,
+		WriteTimeout: 10 * time.Second,
+		ErrorLog:     srv.ErrorLog,
+	}
+
+	httpLn, err := net.Listen("tcp", app.HTTPAddr)
+	if err != nil {
+		ln.Close()
+		return err
+	}
+
+	// If either server fails, stop the other one too.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	errc := make(chan error, 1)
+	go func() {
+		err := app.serve(ctx, httpSrv, httpLn)
+		cancel()
+		errc <- err
+	}()
+
+	app.Logger.Info("starting server", "addr", ln.Addr().String(), "http_addr", app.HTTPAddr)
+	err = app.serve(ctx, srv, ln)
+	cancel()
+
+	if httpErr := <-errc; err == nil {
+		err = httpErr
+	}
+	return err
+}
+
+// RunMetricsServer serves /metrics on its own listener, which can be kept off
+// the public network, until ctx is cancelled.
+func (app *App) RunMetricsServer(ctx context.Context) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", app.Metrics.Handler(app.MetricsToken))
+
+	srv := &http.Server{
+		Addr:         app.MetricsAddr,
+		Handler:      mux,
+		ReadTimeout:  5 * time.Second,
+		WriteTimeout: 10 * time.Second,
+		ErrorLog:     slog.NewLogLogger(app.Logger.Handler(), slog.LevelError),
+	}
+
+	ln, err := net.Listen("tcp", app.MetricsAddr)
+	if err != nil {
+		return err
+	}
+
+	app.Logger.Info("starting metrics server", "addr", app.MetricsAddr)
+	return app.serve(ctx, srv, ln)
+}
+
+// runEvery calls fn straight away and then at every interval until ctx is
+// cancelled.
+func runEvery(ctx context.Context, interval time.Duration, fn func()) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		fn()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
 }
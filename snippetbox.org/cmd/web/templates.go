@@ -0,0 +1,150 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"html/template"
+	"io/fs"
+	"path"
+	"sync"
+	"time"
+
+	"snippetbox.org/pkg/i18n"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// Templates caches every page parsed together with base.html, so pages are
+// parsed once rather than on every request, and a broken template stops the
+// application starting rather than failing when someone visits the page. Each
+// language gets its own copy of the pages, with T bound to it.
+type Templates struct {
+	fsys    fs.FS
+	locales *i18n.Bundle
+
+	mu    sync.RWMutex
+	pages map[string]map[string]*template.Template
+	err   error
+}
+
+// NewTemplates parses every *.page.html in fsys for each language.
+func NewTemplates(fsys fs.FS, locales *i18n.Bundle) (*Templates, error) {
+	t := &Templates{fsys: fsys, locales: locales}
+
+	err := t.Reload()
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// Reload parses the templates again. If they don't parse, the previous
+// templates are kept and the error is returned, and also reported by Err until
+// a reload succeeds.
+func (t *Templates) Reload() error {
+	pages := make(map[string]map[string]*template.Template)
+	var err error
+	for _, l := range t.locales.Locales() {
+		pages[l.Code], err = parsePages(t.fsys, l)
+		if err != nil {
+			break
+		}
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.err = err
+	if err == nil {
+		t.pages = pages
+	}
+	return err
+}
+
+func parsePages(fsys fs.FS, l *i18n.Locale) (map[string]*template.Template, error) {
+	names, err := fs.Glob(fsys, "*.page.html")
+	if err != nil {
+		return nil, err
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("templates: no *.page.html files found")
+	}
+
+	funcs := template.FuncMap{"T": l.T}
+
+	pages := make(map[string]*template.Template, len(names))
+	for _, name := range names {
+		ts, err := template.New(name).Funcs(funcs).ParseFS(fsys, "base.html", name)
+		if err != nil {
+			return nil, err
+		}
+		pages[path.Base(name)] = ts
+	}
+
+	return pages, nil
+}
+
+// Lookup returns the template set for a page in a language.
+func (t *Templates) Lookup(l *i18n.Locale, page string) (*template.Template, error) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	ts, ok := t.pages[l.Code][page]
+	if !ok {
+		return nil, fmt.Errorf("templates: no page %q", page)
+	}
+	return ts, nil
+}
+
+// Err returns the error from the last reload, if it failed.
+func (t *Templates) Err() error {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return t.err
+}
+
+// WatchTemplates reloads the templates whenever a file in dir changes, until
+// ctx is cancelled. It's for development, when the templates are read from
+// disk.
+func (app *App) WatchTemplates(ctx context.Context, dir string) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	defer watcher.Close()
+
+	err = watcher.Add(dir)
+	if err != nil {
+		return err
+	}
+
+	// Editors often write a file in several steps, so wait for a quiet moment
+	// before reloading.
+	var reload <-chan time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case _, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			reload = time.After(100 * time.Millisecond)
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			app.Logger.Error("watching templates", "error", err.Error())
+		case <-reload:
+			reload = nil
+			err := app.Templates.Reload()
+			if err != nil {
+				app.Logger.Error("reloading templates", "error", err.Error())
+			} else {
+				app.Logger.Info("reloaded templates")
+			}
+		}
+	}
+}
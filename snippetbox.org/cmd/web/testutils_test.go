@@ -0,0 +1,150 @@
+package main
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"snippetbox.org/pkg/i18n"
+	"snippetbox.org/pkg/models"
+	"snippetbox.org/pkg/models/sqlite"
+	"snippetbox.org/pkg/ratelimit"
+	"snippetbox.org/pkg/throttle"
+	"snippetbox.org/ui"
+
+	"github.com/alexedwards/scs"
+)
+
+// testMailer keeps the emails sent, instead of sending them.
+type testMailer struct {
+	sent []string
+}
+
+func (m *testMailer) Send(to, subject, body string) error {
+	m.sent = append(m.sent, to+": "+subject)
+	return nil
+}
+
+// newTestApp returns an App backed by a fresh SQLite database, with the
+// built-in templates and locales and everything else kept in memory.
+func newTestApp(t *testing.T) (*App, *sqlite.Database) {
+	t.Helper()
+
+	db, err := sqlite.Open(filepath.Join(t.TempDir(), "snippetbox.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	m, err := db.Migrator()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = m.Up(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	localesFS, err := fs.Sub(ui.Files, "locales")
+	if err != nil {
+		t.Fatal(err)
+	}
+	locales, err := i18n.Load(localesFS, "en")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	htmlFS, err := fs.Sub(ui.Files, "html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	templates, err := NewTemplates(htmlFS, locales)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	staticFS, err := fs.Sub(ui.Files, "static")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	metrics := NewMetrics(db.DB)
+	limits := ratelimit.NewMemStore()
+	throttles := throttle.NewMemStore(time.Hour)
+
+	return &App{
+		AccountThrottle:  throttle.New(throttles),
+		AuthLimit:        ratelimit.New("auth", ratelimit.Per(1000, time.Minute), limits),
+		Background:       func(fn func(ctx context.Context)) { fn(context.Background()) },
+		BaseURL:          "https://snippetbox.test",
+		GlobalLimit:      ratelimit.New("global", ratelimit.Per(1000, time.Minute), limits),
+		IPThrottle:       throttle.New(throttles),
+		Locales:          locales,
+		LockoutMailLimit: ratelimit.New("lockout-mail", ratelimit.Per(3, 24*time.Hour), limits),
+		Logger:           slog.New(slog.NewTextHandler(io.Discard, nil)),
+		Mailer:           &testMailer{},
+		Metrics:          metrics,
+		ResetMailLimit:   ratelimit.New("reset-mail", ratelimit.Per(3, time.Hour), limits),
+		Sessions:         scs.NewManager(metrics.NewSessionStore(strings.Repeat("k", 32))),
+		Snippets:         db,
+		Static:           staticFS,
+		Templates:        templates,
+		TrashDays:        30,
+		Users:            db,
+		WriteLimit:       ratelimit.New("write", ratelimit.Per(1000, time.Minute), limits),
+	}, db
+}
+
+// testClient serves requests to handlers the way a browser would, sending back
+// the cookies set by earlier responses.
+type testClient struct {
+	app     *App
+	cookies map[string]*http.Cookie
+}
+
+func newTestClient(app *App) *testClient {
+	return &testClient{app: app, cookies: make(map[string]*http.Cookie)}
+}
+
+// do serves r to h behind the session and authentication middleware.
+func (c *testClient) do(h http.HandlerFunc, r *http.Request) *httptest.ResponseRecorder {
+	for _, cookie := range c.cookies {
+		r.AddCookie(cookie)
+	}
+
+	rr := httptest.NewRecorder()
+	c.app.Sessions.Use(c.app.Authenticate(h)).ServeHTTP(rr, r)
+
+	for _, cookie := range rr.Result().Cookies() {
+		c.cookies[cookie.Name] = cookie
+	}
+	return rr
+}
+
+// session runs fn with the client's session, such as to log in as a user
+// without going through a login form.
+func (c *testClient) session(fn func(w http.ResponseWriter, s *scs.Session) error) error {
+	var err error
+	c.do(func(w http.ResponseWriter, r *http.Request) {
+		err = fn(w, c.app.Sessions.Load(r))
+	}, httptest.NewRequest(http.MethodGet, "/", nil))
+	return err
+}
+
+// logIn makes the client's session logged in as the user.
+func (c *testClient) logIn(user *models.User) error {
+	return c.session(func(w http.ResponseWriter, s *scs.Session) error {
+		err := s.PutInt(w, "currentUserID", user.ID)
+		if err != nil {
+			return err
+		}
+		return s.PutInt(w, "sessionVersion", user.SessionVersion)
+	})
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"net/http"
+	"sync"
+	"time"
+	_ "time/tzdata"
+
+	"snippetbox.org/pkg/forms"
+	"snippetbox.org/pkg/models"
+)
+
+// zones caches loaded timezones by name, as time.LoadLocation reads the zone
+// database each time it's called.
+var zones sync.Map
+
+func loadZone(name string) (*time.Location, error) {
+	if loc, ok := zones.Load(name); ok {
+		return loc.(*time.Location), nil
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, err
+	}
+
+	zones.Store(name, loc)
+	return loc, nil
+}
+
+// browserZone returns the timezone the browser reported for the session, or
+// an empty string if it hasn't reported one.
+func (app *App) browserZone(r *http.Request) string {
+	// Like the language, a session that can't be read isn't worth failing
+	// the request over; dates are shown in UTC instead.
+	zone, err := app.Sessions.Load(r).GetString("timezone")
+	if err != nil {
+		return ""
+	}
+	return zone
+}
+
+// Timezone returns the zone to show dates in: the one the user picked, if
+// they're logged in and have picked one, otherwise the one their browser
+// reported, otherwise UTC.
+func (app *App) Timezone(r *http.Request, user *models.User) *time.Location {
+	for _, name := range []string{userZone(user), app.browserZone(r)} {
+		if name == "" {
+			continue
+		}
+		loc, err := loadZone(name)
+		if err == nil {
+			return loc
+		}
+	}
+
+	return time.UTC
+}
+
+func userZone(user *models.User) string {
+	if user == nil {
+		return ""
+	}
+	return user.Timezone
+}
+
+// SetBrowserTimezone saves the timezone the browser reports in the session.
+// Every page sends it from timezone.js when it differs from the one saved.
+func (app *App) SetBrowserTimezone(w http.ResponseWriter, r *http.Request) {
+	err := r.ParseForm()
+	if err != nil {
+		app.ClientError(w, http.StatusBadRequest)
+		return
+	}
+
+	zone := r.PostForm.Get("timezone")
+	if !forms.ValidTimezone(zone) {
+		app.ClientError(w, http.StatusBadRequest)
+		return
+	}
+
+	err = app.Sessions.Load(r).PutString(w, "timezone", zone)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (app *App) Preferences(w http.ResponseWriter, r *http.Request) {
+	user, err := app.CurrentUser(r)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	flash, err := app.Sessions.Load(r).PopString(w, "flash")
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	app.RenderHTML(w, r, "preferences.page.html", &HTMLData{
+		Flash: flash,
+		Form:  &forms.Preferences{Timezone: user.Timezone},
+	})
+}
+
+func (app *App) UpdatePreferences(w http.ResponseWriter, r *http.Request) {
+	err := r.ParseForm()
+	if err != nil {
+		app.ClientError(w, http.StatusBadRequest)
+		return
+	}
+
+	form := &forms.Preferences{
+		Timezone: r.PostForm.Get("timezone"),
+	}
+
+	if !form.Valid() {
+		app.RenderHTML(w, r, "preferences.page.html", &HTMLData{Form: form})
+		return
+	}
+
+	user, err := app.CurrentUser(r)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	err = app.Users.SetTimezone(r.Context(), user.ID, form.Timezone)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	err = app.Sessions.Load(r).PutString(w, "flash", app.T(r, "Your preferences were saved."))
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	http.Redirect(w, r, "/user/preferences", http.StatusSeeOther)
+}
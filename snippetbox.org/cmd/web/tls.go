@@ -0,0 +1,282 @@
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/acme"
+	"golang.org/x/crypto/acme/autocert"
+)
+
+// How often certReloader looks at the certificate files for changes.
+const certCheckInterval = 10 * time.Second
+
+// ACMEConfig turns on automatic certificates from an ACME CA such as Let's
+// Encrypt, in place of the certificate files.
+type ACMEConfig struct {
+	// Domains are the host names to get certificates for. Requests for any
+	// other host get no certificate.
+	Domains []string
+
+	// CacheDir is where certificates and the account key are kept, so they
+	// survive restarts.
+	CacheDir string
+
+	// Email is the contact address for the ACME account. It's optional.
+	Email string
+
+	// DirectoryURL is the CA's directory. It defaults to Let's Encrypt.
+	DirectoryURL string
+
+	// CACert is a PEM file of extra root certificates to trust when talking to
+	// the CA, for test CAs such as Pebble. It's optional.
+	CACert string
+}
+
+// Manager returns an autocert manager for the config.
+func (c *ACMEConfig) Manager() (*autocert.Manager, error) {
+	client := &acme.Client{DirectoryURL: c.DirectoryURL}
+	if client.DirectoryURL == "" {
+		client.DirectoryURL = autocert.DefaultACMEDirectory
+	}
+
+	if c.CACert != "" {
+		pem, err := os.ReadFile(c.CACert)
+		if err != nil {
+			return nil, err
+		}
+
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, errors.New("acme: no certificates found in " + c.CACert)
+		}
+
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool}
+		client.HTTPClient = &http.Client{Transport: transport}
+	}
+
+	return &autocert.Manager{
+		Prompt:     autocert.AcceptTOS,
+		Cache:      autocert.DirCache(c.CacheDir),
+		HostPolicy: autocert.HostWhitelist(c.Domains...),
+		Email:      c.Email,
+		Client:     client,
+	}, nil
+}
+
+// certReloader serves the certificate in a pair of files, and loads them again
+// when they change, so a renewed certificate is picked up without a restart.
+type certReloader struct {
+	certFile string
+	keyFile  string
+	logger   *slog.Logger
+
+	mu      sync.Mutex
+	cert    *tls.Certificate
+	modTime time.Time
+	checked time.Time
+}
+
+func newCertReloader(certFile, keyFile string, logger *slog.Logger) (*certReloader, error) {
+	c := &certReloader{certFile: certFile, keyFile: keyFile, logger: logger}
+
+	modTime, err := c.latestModTime()
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.load(modTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// latestModTime returns when either file was last changed.
+func (c *certReloader) latestModTime() (time.Time, error) {
+	var latest time.Time
+	for _, name := range []string{c.certFile, c.keyFile} {
+		fi, err := os.Stat(name)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if fi.ModTime().After(latest) {
+			latest = fi.ModTime()
+		}
+	}
+	return latest, nil
+}
+
+func (c *certReloader) load(modTime time.Time) error {
+	cert, err := tls.LoadX509KeyPair(c.certFile, c.keyFile)
+	if err != nil {
+		return err
+	}
+
+	c.cert = &cert
+	c.modTime = modTime
+	return nil
+}
+
+// GetCertificate is for tls.Config. If the files have changed but can't be
+// loaded, perhaps because only one has been replaced so far, it keeps serving
+// the previous certificate.
+func (c *certReloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(c.checked) < certCheckInterval {
+		return c.cert, nil
+	}
+	c.checked = now
+
+	modTime, err := c.latestModTime()
+	if err == nil && modTime.After(c.modTime) {
+		err = c.load(modTime)
+		if err == nil {
+			c.logger.Info("reloaded TLS certificate", "cert", c.certFile)
+		}
+	}
+	if err != nil {
+		c.logger.Error("reloading TLS certificate", "error", err.Error())
+	}
+
+	return c.cert, nil
+}
+
+// redirectToHTTPS sends plain HTTP requests to the same URL on the TLS address.
+func (app *App) redirectToHTTPS(w http.ResponseWriter, r *http.Request) {
+	host, _, err := net.SplitHostPort(r.Host)
+	if err != nil {
+		host = r.Host
+	}
+
+	_, port, err := net.SplitHostPort(app.Addr)
+	if err == nil && port != "" && port != "443" {
+		host = net.JoinHostPort(host, port)
+	}
+
+	http.Redirect(w, r, "https://"+host+r.URL.RequestURI(), http.StatusMovedPermanently)
+}
+
+// TLS 1.2 cipher suite profiles. The modern profile only has forward secret
+// AEAD suites. The intermediate one adds the forward secret CBC suites, for
+// older clients that have no AEAD suites. TLS 1.3 suites aren't configurable,
+// and are all of the modern kind anyway.
+var tlsCipherProfiles = map[string][]uint16{
+	"modern": {
+		tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
+		tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+		tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
+		tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
+		tls.TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256,
+		tls.TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305_SHA256,
+	},
+	"intermediate": {
+		tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
+		tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+		tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
+		tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
+		tls.TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256,
+		tls.TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305_SHA256,
+		tls.TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA,
+		tls.TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA,
+		tls.TLS_ECDHE_ECDSA_WITH_AES_256_CBC_SHA,
+		tls.TLS_ECDHE_RSA_WITH_AES_256_CBC_SHA,
+	},
+}
+
+// parseTLSCiphers parses the TLS 1.2 cipher suite policy: the name of a
+// profile, or a comma-separated list of suite names as crypto/tls spells them,
+// such as TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256. Suites that crypto/tls
+// considers insecure, and TLS 1.3 suites, are refused.
+func parseTLSCiphers(s string) ([]uint16, error) {
+	if suites, ok := tlsCipherProfiles[s]; ok {
+		return suites, nil
+	}
+
+	known := make(map[string]*tls.CipherSuite)
+	for _, c := range tls.CipherSuites() {
+		known[c.Name] = c
+	}
+	insecure := make(map[string]bool)
+	for _, c := range tls.InsecureCipherSuites() {
+		insecure[c.Name] = true
+	}
+
+	var suites []uint16
+	for _, name := range strings.Split(s, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		c, ok := known[name]
+		switch {
+		case insecure[name]:
+			return nil, fmt.Errorf("tls: cipher suite %s is insecure", name)
+		case !ok:
+			return nil, fmt.Errorf("tls: unknown cipher suite %q (use modern, intermediate or a list of suite names)", name)
+		case !slices.Contains(c.SupportedVersions, tls.VersionTLS12):
+			return nil, fmt.Errorf("tls: cipher suite %s isn't for TLS 1.2", name)
+		}
+		suites = append(suites, c.ID)
+	}
+
+	if len(suites) == 0 {
+		return nil, errors.New("tls: no cipher suites given")
+	}
+	return suites, nil
+}
+
+// parseTLSVersion parses a minimum TLS version, "1.2" or "1.3".
+func parseTLSVersion(s string) (uint16, error) {
+	switch s {
+	case "1.2":
+		return tls.VersionTLS12, nil
+	case "1.3":
+		return tls.VersionTLS13, nil
+	}
+	return 0, fmt.Errorf("tls: unsupported minimum version %q (use 1.2 or 1.3)", s)
+}
+
+// hstsHeader returns the Strict-Transport-Security value for the options, or
+// an empty string if maxAge is zero. Browsers' preload lists only accept sites
+// that cover subdomains for at least a year.
+func hstsHeader(maxAge time.Duration, includeSubdomains, preload bool) (string, error) {
+	if maxAge <= 0 {
+		if preload {
+			return "", errors.New("hsts: preload needs a max age")
+		}
+		return "", nil
+	}
+
+	if preload && (!includeSubdomains || maxAge < 365*24*time.Hour) {
+		return "", errors.New("hsts: preload needs subdomains included and a max age of at least a year")
+	}
+
+	header := fmt.Sprintf("max-age=%d", int(maxAge.Seconds()))
+	if includeSubdomains {
+		header += "; includeSubDomains"
+	}
+	if preload {
+		header += "; preload"
+	}
+	return header, nil
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"crypto/tls"
+	"slices"
+	"testing"
+)
+
+func TestParseTLSCiphers(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    []uint16
+		wantErr bool
+	}{
+		{"modern", tlsCipherProfiles["modern"], false},
+		{"intermediate", tlsCipherProfiles["intermediate"], false},
+		{
+			"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256, TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305_SHA256",
+			[]uint16{tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256, tls.TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305_SHA256},
+			false,
+		},
+		{"", nil, true},
+		{" , ", nil, true},
+		{"legacy", nil, true},
+		{"TLS_RSA_WITH_RC4_128_SHA", nil, true},
+		{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,TLS_ECDHE_RSA_WITH_3DES_EDE_CBC_SHA", nil, true},
+		{"TLS_AES_128_GCM_SHA256", nil, true},
+	}
+
+	for _, tt := range tests {
+		got, err := parseTLSCiphers(tt.in)
+		if (err != nil) != tt.wantErr || !slices.Equal(got, tt.want) {
+			t.Errorf("parseTLSCiphers(%q) = %v, %v; want %v, error %v", tt.in, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+// Every suite in the profiles has to be one crypto/tls considers secure.
+func TestTLSCipherProfiles(t *testing.T) {
+	for name, suites := range tlsCipherProfiles {
+		for _, id := range suites {
+			if !slices.ContainsFunc(tls.CipherSuites(), func(c *tls.CipherSuite) bool { return c.ID == id }) {
+				t.Errorf("profile %s has insecure suite %s", name, tls.CipherSuiteName(id))
+			}
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"snippetbox.org/pkg/models"
+)
+
+// trashOwner returns whose trash the logged in user can see and restore from:
+// 0 for moderators, who can restore any snippet, otherwise the user's own ID,
+// which only reaches the snippets they deleted themselves.
+func (app *App) trashOwner(r *http.Request) (int, error) {
+	canDelete, err := app.Can(r, models.PermDeleteSnippets)
+	if err != nil || canDelete {
+		return 0, err
+	}
+
+	return app.Sessions.Load(r).GetInt("currentUserID")
+}
+
+func (app *App) Trash(w http.ResponseWriter, r *http.Request) {
+	owner, err := app.trashOwner(r)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	snippets, err := app.Snippets.TrashedSnippets(r.Context(), owner, app.TrashDays)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	session := app.Sessions.Load(r)
+	flash, err := session.PopString(w, "flash")
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	app.RenderHTML(w, r, "trash.page.html", &HTMLData{
+		Flash:     flash,
+		Snippets:  snippets,
+		TrashDays: app.TrashDays,
+	})
+}
+
+func (app *App) RestoreSnippet(w http.ResponseWriter, r *http.Request) {
+	err := r.ParseForm()
+	if err != nil {
+		app.ClientError(w, http.StatusBadRequest)
+		return
+	}
+
+	id, err := strconv.Atoi(r.PostForm.Get("id"))
+	if err != nil || id < 1 {
+		app.ClientError(w, http.StatusBadRequest)
+		return
+	}
+
+	owner, err := app.trashOwner(r)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	restored, err := app.Snippets.RestoreSnippet(r.Context(), id, owner, app.TrashDays)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	msg := app.T(r, "Snippet #%d can't be restored.", id)
+	if restored {
+		err = app.Audit(r, models.AuditSnippetRestored, fmt.Sprintf("snippet %d", id))
+		if err != nil {
+			app.ServerError(w, err)
+			return
+		}
+		msg = app.T(r, "Snippet #%d was restored.", id)
+	}
+
+	session := app.Sessions.Load(r)
+	err = session.PutString(w, "flash", msg)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	http.Redirect(w, r, "/snippet/trash", http.StatusSeeOther)
+}
+
+// PurgeTrash permanently deletes snippets whose retention window has passed.
+func (app *App) PurgeTrash(ctx context.Context) {
+	n, err := app.Snippets.PurgeSnippets(ctx, app.TrashDays)
+	if err != nil {
+		app.Logger.Error("purging trash", "error", err.Error())
+	} else if n > 0 {
+		app.Logger.Info("purged trash", "snippets", n)
+	}
+}
@@ -0,0 +1,528 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"html/template"
+	"image/png"
+	"net/http"
+	"time"
+
+	"snippetbox.org/pkg/forms"
+	"snippetbox.org/pkg/models"
+
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/totp"
+)
+
+// How long a half logged-in user has to enter their code before they have to
+// start again with their password. Their attempts at the code are throttled
+// by user ID like failed logins, so starting again doesn't give them more.
+const pendingLoginLifetime = 5 * time.Minute
+
+// TOTP codes change every totpPeriod seconds. A code is accepted for the steps
+// either side of now too, to allow for clocks that have drifted.
+const totpPeriod = 30
+
+func twoFactorKey(userID int) string {
+	return fmt.Sprintf("2fa:%d", userID)
+}
+
+// totpStep returns the time step the code is valid for, if any.
+func totpStep(secret, code string, now time.Time) (int64, bool) {
+	opts := totp.ValidateOpts{
+		Period:    totpPeriod,
+		Digits:    otp.DigitsSix,
+		Algorithm: otp.AlgorithmSHA1,
+	}
+
+	step := now.Unix() / totpPeriod
+	for _, s := range []int64{step, step - 1, step + 1} {
+		ok, err := totp.ValidateCustom(code, secret, time.Unix(s*totpPeriod, 0).UTC(), opts)
+		if err == nil && ok {
+			return s, true
+		}
+	}
+
+	return 0, false
+}
+
+// checkSecondFactor accepts either a current TOTP code or one of the user's
+// unused recovery codes. Each TOTP code only works once: a code is refused if
+// the user has already used it, or a newer one.
+func (app *App) checkSecondFactor(ctx context.Context, user *models.User, code string) (bool, error) {
+	if step, ok := totpStep(user.TOTPSecret, code, time.Now()); ok {
+		return app.Users.UseTOTPStep(ctx, user.ID, step)
+	}
+
+	return app.Users.UseRecoveryCode(ctx, user.ID, code)
+}
+
+// StartTwoFactor half logs in a user who has two-factor authentication turned
+// on, once their password or identity provider has vouched for them. It
+// remembers who they are in the session and asks for a code.
+func (app *App) StartTwoFactor(w http.ResponseWriter, r *http.Request, user *models.User) {
+	session := app.Sessions.Load(r)
+	err := session.PutInt(w, "pendingUserID", user.ID)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	err = session.PutTime(w, "pendingSince", time.Now())
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	http.Redirect(w, r, "/user/login/2fa", http.StatusSeeOther)
+}
+
+func (app *App) LoginTwoFactor(w http.ResponseWriter, r *http.Request) {
+	session := app.Sessions.Load(r)
+	pending, err := session.Exists("pendingUserID")
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	if !pending {
+		http.Redirect(w, r, "/user/login", http.StatusSeeOther)
+		return
+	}
+
+	app.RenderHTML(w, r, "login.2fa.page.html", &HTMLData{
+		Form: &forms.TwoFactorCode{},
+	})
+}
+
+func (app *App) VerifyTwoFactor(w http.ResponseWriter, r *http.Request) {
+	err := r.ParseForm()
+	if err != nil {
+		app.ClientError(w, http.StatusBadRequest)
+		return
+	}
+
+	session := app.Sessions.Load(r)
+	userID, err := session.GetInt("pendingUserID")
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+	since, err := session.GetTime("pendingSince")
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	// Send the user back to the password form if they never got past it, or took
+	// too long.
+	if userID == 0 || time.Since(since) > pendingLoginLifetime {
+		for _, key := range []string{"pendingUserID", "pendingSince"} {
+			err = session.Remove(w, key)
+			if err != nil {
+				app.ServerError(w, err)
+				return
+			}
+		}
+
+		err = session.PutString(w, "flash", app.T(r, "Your login has expired. Please log in again."))
+		if err != nil {
+			app.ServerError(w, err)
+			return
+		}
+
+		http.Redirect(w, r, "/user/login", http.StatusSeeOther)
+		return
+	}
+
+	form := &forms.TwoFactorCode{
+		Code: r.PostForm.Get("code"),
+	}
+
+	if !form.Valid() {
+		app.RenderHTML(w, r, "login.2fa.page.html", &HTMLData{Form: form})
+		return
+	}
+
+	user, err := app.Users.GetUser(r.Context(), userID)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+	if user == nil {
+		app.ClientError(w, http.StatusBadRequest)
+		return
+	}
+
+	// Codes are throttled like passwords, by the client's IP and by the user, so
+	// that they can't be guessed by logging in again and again.
+	wait, err := app.loginWait(r, user.Email)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+	codeWait, err := app.AccountThrottle.Wait(r.Context(), twoFactorKey(user.ID))
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+	if codeWait > wait {
+		wait = codeWait
+	}
+	if wait > 0 {
+		form.Failures["Code"] = app.T(r, "Too many failed attempts. Please try again in %s.", app.Locale(r).Duration(wait))
+		app.RenderHTML(w, r, "login.2fa.page.html", &HTMLData{Form: form})
+		return
+	}
+
+	ok, err := app.checkSecondFactor(r.Context(), user, form.Code)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	if !ok {
+		_, err = app.AccountThrottle.Fail(r.Context(), twoFactorKey(user.ID))
+		if err != nil {
+			app.ServerError(w, err)
+			return
+		}
+
+		err = app.loginFailed(r, user.Email)
+		if err != nil {
+			app.ServerError(w, err)
+			return
+		}
+
+		app.Metrics.LoginsFailed.Inc()
+
+		err = app.AuditAs(r, 0, models.AuditLoginFailed, user.Email)
+		if err != nil {
+			app.ServerError(w, err)
+			return
+		}
+
+		form.Failures["Code"] = "Code is incorrect"
+		app.RenderHTML(w, r, "login.2fa.page.html", &HTMLData{Form: form})
+		return
+	}
+
+	err = app.AccountThrottle.Reset(r.Context(), twoFactorKey(user.ID))
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	app.CompleteLogin(w, r, user)
+}
+
+func (app *App) TwoFactor(w http.ResponseWriter, r *http.Request) {
+	user, err := app.CurrentUser(r)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	session := app.Sessions.Load(r)
+	flash, err := session.PopString(w, "flash")
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	data := &HTMLData{
+		Flash: flash,
+		Form:  &forms.TwoFactorCode{},
+		User:  user,
+	}
+
+	if user.TOTPEnabled {
+		app.RenderHTML(w, r, "twofactor.page.html", data)
+		return
+	}
+
+	// Generate a new secret for the user to scan. It's kept in the session until
+	// they prove their authenticator app works by entering a code from it.
+	key, err := totp.Generate(totp.GenerateOpts{
+		Issuer:      "Snippetbox",
+		AccountName: user.Email,
+	})
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	err = session.PutString(w, "totpSetupURL", key.URL())
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	data.TOTP, err = newTOTPSetup(key)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	app.RenderHTML(w, r, "twofactor.page.html", data)
+}
+
+func (app *App) EnableTwoFactor(w http.ResponseWriter, r *http.Request) {
+	err := r.ParseForm()
+	if err != nil {
+		app.ClientError(w, http.StatusBadRequest)
+		return
+	}
+
+	user, err := app.CurrentUser(r)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	session := app.Sessions.Load(r)
+	setupURL, err := session.GetString("totpSetupURL")
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+	if setupURL == "" {
+		http.Redirect(w, r, "/user/2fa", http.StatusSeeOther)
+		return
+	}
+
+	key, err := otp.NewKeyFromURL(setupURL)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	form := &forms.TwoFactorCode{
+		Code: r.PostForm.Get("code"),
+	}
+
+	if form.Valid() && !totp.Validate(form.Code, key.Secret()) {
+		form.Failures["Code"] = "Code is incorrect"
+	}
+
+	if len(form.Failures) > 0 {
+		setup, err := newTOTPSetup(key)
+		if err != nil {
+			app.ServerError(w, err)
+			return
+		}
+
+		app.RenderHTML(w, r, "twofactor.page.html", &HTMLData{Form: form, TOTP: setup, User: user})
+		return
+	}
+
+	codes, err := app.Users.EnableTOTP(r.Context(), user.ID, key.Secret())
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	err = app.Audit(r, models.AuditTwoFactorOn, user.Email)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	err = session.Remove(w, "totpSetupURL")
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	app.RenderHTML(w, r, "twofactor.codes.page.html", &HTMLData{RecoveryCodes: codes})
+}
+
+func (app *App) DisableTwoFactor(w http.ResponseWriter, r *http.Request) {
+	app.confirmTwoFactor(w, r, func(user *models.User) {
+		err := app.Users.DisableTOTP(r.Context(), user.ID)
+		if err != nil {
+			app.ServerError(w, err)
+			return
+		}
+
+		err = app.Audit(r, models.AuditTwoFactorOff, user.Email)
+		if err != nil {
+			app.ServerError(w, err)
+			return
+		}
+
+		session := app.Sessions.Load(r)
+		err = session.PutString(w, "flash", app.T(r, "Two-factor authentication has been turned off."))
+		if err != nil {
+			app.ServerError(w, err)
+			return
+		}
+
+		http.Redirect(w, r, "/user/2fa", http.StatusSeeOther)
+	})
+}
+
+func (app *App) RegenerateRecoveryCodes(w http.ResponseWriter, r *http.Request) {
+	app.confirmTwoFactor(w, r, func(user *models.User) {
+		codes, err := app.Users.RegenerateRecoveryCodes(r.Context(), user.ID)
+		if err != nil {
+			app.ServerError(w, err)
+			return
+		}
+
+		app.RenderHTML(w, r, "twofactor.codes.page.html", &HTMLData{RecoveryCodes: codes})
+	})
+}
+
+// confirmTwoFactor re-checks a code from a logged in user before letting them
+// change their 2FA settings, and calls next if it's correct. Wrong codes are
+// throttled by user like those at login, so that someone with a stolen session
+// can't guess their way to turning 2FA off.
+func (app *App) confirmTwoFactor(w http.ResponseWriter, r *http.Request, next func(*models.User)) {
+	err := r.ParseForm()
+	if err != nil {
+		app.ClientError(w, http.StatusBadRequest)
+		return
+	}
+
+	user, err := app.CurrentUser(r)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+	if !user.TOTPEnabled {
+		http.Redirect(w, r, "/user/2fa", http.StatusSeeOther)
+		return
+	}
+
+	form := &forms.TwoFactorCode{
+		Code: r.PostForm.Get("code"),
+	}
+
+	if form.Valid() {
+		ok, err := app.checkTwoFactorCode(r, user, form)
+		if err != nil {
+			app.ServerError(w, err)
+			return
+		}
+		if ok {
+			next(user)
+			return
+		}
+	}
+
+	app.RenderHTML(w, r, "twofactor.page.html", &HTMLData{Form: form, User: user})
+}
+
+// checkTwoFactorCode checks the form's code for the logged in user, counting
+// wrong codes against the user's 2FA throttle key. It reports whether the code
+// was correct, and if it wasn't, says why in the form's failures.
+func (app *App) checkTwoFactorCode(r *http.Request, user *models.User, form *forms.TwoFactorCode) (bool, error) {
+	wait, err := app.AccountThrottle.Wait(r.Context(), twoFactorKey(user.ID))
+	if err != nil {
+		return false, err
+	}
+	if wait > 0 {
+		form.Failures["Code"] = app.T(r, "Too many failed attempts. Please try again in %s.", app.Locale(r).Duration(wait))
+		return false, nil
+	}
+
+	ok, err := app.checkSecondFactor(r.Context(), user, form.Code)
+	if err != nil {
+		return false, err
+	}
+	if !ok {
+		form.Failures["Code"] = "Code is incorrect"
+		_, err = app.AccountThrottle.Fail(r.Context(), twoFactorKey(user.ID))
+		return false, err
+	}
+
+	return true, app.AccountThrottle.Reset(r.Context(), twoFactorKey(user.ID))
+}
+
+func (app *App) EditSettings(w http.ResponseWriter, r *http.Request) {
+	required, err := app.Users.RequireAdmin2FA(r.Context())
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	session := app.Sessions.Load(r)
+	flash, err := session.PopString(w, "flash")
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	app.RenderHTML(w, r, "admin.settings.page.html", &HTMLData{
+		Flash: flash,
+		Form:  &forms.AdminSettings{RequireAdmin2FA: required},
+	})
+}
+
+func (app *App) UpdateSettings(w http.ResponseWriter, r *http.Request) {
+	err := r.ParseForm()
+	if err != nil {
+		app.ClientError(w, http.StatusBadRequest)
+		return
+	}
+
+	form := &forms.AdminSettings{
+		RequireAdmin2FA: r.PostForm.Get("require_admin_2fa") == "on",
+	}
+
+	if !form.Valid() {
+		app.RenderHTML(w, r, "admin.settings.page.html", &HTMLData{Form: form})
+		return
+	}
+
+	err = app.Users.SetRequireAdmin2FA(r.Context(), form.RequireAdmin2FA)
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	err = app.Audit(r, models.AuditSettingsChanged, fmt.Sprintf("require_admin_2fa=%t", form.RequireAdmin2FA))
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	session := app.Sessions.Load(r)
+	err = session.PutString(w, "flash", app.T(r, "Settings saved."))
+	if err != nil {
+		app.ServerError(w, err)
+		return
+	}
+
+	http.Redirect(w, r, "/admin/settings", http.StatusSeeOther)
+}
+
+// TOTPSetup holds what the 2FA setup page needs to show a new secret: the
+// secret itself, for typing in by hand, and a QR code of the otpauth:// URL as a
+// data URL.
+type TOTPSetup struct {
+	Secret string
+	QRCode template.URL
+}
+
+func newTOTPSetup(key *otp.Key) (*TOTPSetup, error) {
+	img, err := key.Image(200, 200)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := new(bytes.Buffer)
+	err = png.Encode(buf, img)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TOTPSetup{
+		Secret: key.Secret(),
+		QRCode: template.URL("data:image/png;base64," + base64.StdEncoding.EncodeToString(buf.Bytes())),
+	}, nil
+}
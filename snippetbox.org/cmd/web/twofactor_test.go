@@ -0,0 +1,280 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"snippetbox.org/pkg/models"
+
+	"github.com/alexedwards/scs"
+	"github.com/pquerna/otp/totp"
+)
+
+func TestTOTPStep(t *testing.T) {
+	key, err := totp.Generate(totp.GenerateOpts{Issuer: "Snippetbox", AccountName: "alice@example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Unix(1700000000, 0)
+	step := now.Unix() / totpPeriod
+
+	tests := []struct {
+		name     string
+		at       time.Time
+		wantStep int64
+		wantOK   bool
+	}{
+		{"Now", now, step, true},
+		{"Previous step", now.Add(-totpPeriod * time.Second), step - 1, true},
+		{"Next step", now.Add(totpPeriod * time.Second), step + 1, true},
+		{"Too old", now.Add(-3 * totpPeriod * time.Second), 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, err := totp.GenerateCode(key.Secret(), tt.at)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, ok := totpStep(key.Secret(), code, now)
+			if got != tt.wantStep || ok != tt.wantOK {
+				t.Errorf("got %d, %v; want %d, %v", got, ok, tt.wantStep, tt.wantOK)
+			}
+		})
+	}
+
+	if _, ok := totpStep(key.Secret(), "not a code", now); ok {
+		t.Error("accepted a code that isn't a number")
+	}
+}
+
+// twoFactorTest is a user with 2FA turned on, and a client that has entered
+// their password and been asked for a code.
+type twoFactorTest struct {
+	app    *App
+	client *testClient
+	user   *models.User
+	secret string
+	codes  []string
+}
+
+func newTwoFactorTest(t *testing.T) *twoFactorTest {
+	t.Helper()
+	ctx := context.Background()
+	app, db := newTestApp(t)
+
+	err := db.InsertUser(ctx, "Alice", "alice@example.com", "correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	user, err := db.GetUserByEmail(ctx, "alice@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	key, err := totp.Generate(totp.GenerateOpts{Issuer: "Snippetbox", AccountName: user.Email})
+	if err != nil {
+		t.Fatal(err)
+	}
+	codes, err := db.EnableTOTP(ctx, user.ID, key.Secret())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tt := &twoFactorTest{app: app, client: newTestClient(app), user: user, secret: key.Secret(), codes: codes}
+	tt.startLogin(t, time.Now())
+	return tt
+}
+
+// startLogin half logs the client in, as if they'd entered their password at
+// the given time.
+func (tt *twoFactorTest) startLogin(t *testing.T, at time.Time) {
+	t.Helper()
+
+	err := tt.client.session(func(w http.ResponseWriter, s *scs.Session) error {
+		err := s.PutInt(w, "pendingUserID", tt.user.ID)
+		if err != nil {
+			return err
+		}
+		return s.PutTime(w, "pendingSince", at)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// verify posts the code to the 2FA login form.
+func (tt *twoFactorTest) verify(code string) *httptest.ResponseRecorder {
+	form := url.Values{"code": {code}}
+	r := httptest.NewRequest(http.MethodPost, "/user/login/2fa", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return tt.client.do(tt.app.VerifyTwoFactor, r)
+}
+
+// loggedIn reports whether the client is now fully logged in.
+func (tt *twoFactorTest) loggedIn(t *testing.T) bool {
+	t.Helper()
+
+	var loggedIn bool
+	tt.client.do(func(w http.ResponseWriter, r *http.Request) {
+		loggedIn, _ = tt.app.LoggedIn(r)
+	}, httptest.NewRequest(http.MethodGet, "/", nil))
+	return loggedIn
+}
+
+func (tt *twoFactorTest) currentCode(t *testing.T) string {
+	t.Helper()
+
+	code, err := totp.GenerateCode(tt.secret, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return code
+}
+
+func TestVerifyTwoFactor(t *testing.T) {
+	t.Run("Correct code", func(t *testing.T) {
+		tt := newTwoFactorTest(t)
+
+		rr := tt.verify(tt.currentCode(t))
+		if rr.Code != http.StatusSeeOther || !tt.loggedIn(t) {
+			t.Errorf("got status %d and logged in %v; want 303 and true", rr.Code, tt.loggedIn(t))
+		}
+	})
+
+	t.Run("Wrong code", func(t *testing.T) {
+		tt := newTwoFactorTest(t)
+
+		rr := tt.verify("000000")
+		if rr.Code != http.StatusOK || tt.loggedIn(t) {
+			t.Fatalf("got status %d and logged in %v; want 200 and false", rr.Code, tt.loggedIn(t))
+		}
+
+		// The failure counts against the user and the client's IP, wherever
+		// the session goes.
+		ctx := context.Background()
+		for _, key := range []string{twoFactorKey(tt.user.ID), ipKey("192.0.2.1")} {
+			e, found, err := tt.app.AccountThrottle.Store.Find(ctx, key)
+			if err != nil || !found || e.Failures != 1 {
+				t.Errorf("throttle entry for %s: got %+v, %v, %v; want 1 failure", key, e, found, err)
+			}
+		}
+	})
+
+	t.Run("Code used twice", func(t *testing.T) {
+		tt := newTwoFactorTest(t)
+		code := tt.currentCode(t)
+
+		tt.verify(code)
+		tt.client.cookies = make(map[string]*http.Cookie)
+		tt.startLogin(t, time.Now())
+
+		rr := tt.verify(code)
+		if rr.Code != http.StatusOK || tt.loggedIn(t) {
+			t.Errorf("replaying a code: got status %d and logged in %v; want 200 and false", rr.Code, tt.loggedIn(t))
+		}
+	})
+
+	t.Run("Recovery code", func(t *testing.T) {
+		tt := newTwoFactorTest(t)
+
+		rr := tt.verify(tt.codes[0])
+		if rr.Code != http.StatusSeeOther || !tt.loggedIn(t) {
+			t.Fatalf("got status %d and logged in %v; want 303 and true", rr.Code, tt.loggedIn(t))
+		}
+
+		tt.client.cookies = make(map[string]*http.Cookie)
+		tt.startLogin(t, time.Now())
+
+		rr = tt.verify(tt.codes[0])
+		if rr.Code != http.StatusOK || tt.loggedIn(t) {
+			t.Errorf("reusing a recovery code: got status %d and logged in %v; want 200 and false", rr.Code, tt.loggedIn(t))
+		}
+	})
+
+	t.Run("Throttled", func(t *testing.T) {
+		tt := newTwoFactorTest(t)
+
+		for i := 0; i <= tt.app.AccountThrottle.FreeAttempts; i++ {
+			tt.verify("000000")
+		}
+
+		// Starting again from the password doesn't clear the count.
+		tt.client.cookies = make(map[string]*http.Cookie)
+		tt.startLogin(t, time.Now())
+
+		rr := tt.verify(tt.currentCode(t))
+		if !strings.Contains(rr.Body.String(), "Too many failed attempts") || tt.loggedIn(t) {
+			t.Errorf("got logged in %v; want a wait message", tt.loggedIn(t))
+		}
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		tt := newTwoFactorTest(t)
+		tt.startLogin(t, time.Now().Add(-pendingLoginLifetime-time.Minute))
+
+		rr := tt.verify(tt.currentCode(t))
+		if rr.Code != http.StatusSeeOther || rr.Header().Get("Location") != "/user/login" || tt.loggedIn(t) {
+			t.Errorf("got status %d to %q; want 303 to /user/login", rr.Code, rr.Header().Get("Location"))
+		}
+	})
+}
+
+func TestDisableTwoFactor(t *testing.T) {
+	tt := newTwoFactorTest(t)
+	ctx := context.Background()
+	err := tt.client.logIn(tt.user)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	disable := func(code string) *httptest.ResponseRecorder {
+		form := url.Values{"code": {code}}
+		r := httptest.NewRequest(http.MethodPost, "/user/2fa/disable", strings.NewReader(form.Encode()))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		return tt.client.do(tt.app.DisableTwoFactor, r)
+	}
+
+	// Wrong codes are counted against the user's 2FA key, so once there have
+	// been a few, even the right code has to wait.
+	for i := 0; i <= tt.app.AccountThrottle.FreeAttempts; i++ {
+		disable("000000")
+	}
+
+	e, _, err := tt.app.AccountThrottle.Store.Find(ctx, twoFactorKey(tt.user.ID))
+	if err != nil || e.Failures != tt.app.AccountThrottle.FreeAttempts+1 {
+		t.Errorf("got %d failures, %v; want %d", e.Failures, err, tt.app.AccountThrottle.FreeAttempts+1)
+	}
+
+	rr := disable(tt.currentCode(t))
+	if !strings.Contains(rr.Body.String(), "Too many failed attempts") {
+		t.Errorf("got status %d; want a wait message", rr.Code)
+	}
+
+	user, err := tt.app.Users.GetUser(ctx, tt.user.ID)
+	if err != nil || !user.TOTPEnabled {
+		t.Errorf("2FA was turned off: %+v, %v", user, err)
+	}
+
+	// Once the wait is over, the right code works.
+	err = tt.app.AccountThrottle.Reset(ctx, twoFactorKey(tt.user.ID))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rr = disable(tt.currentCode(t))
+	if rr.Code != http.StatusSeeOther {
+		t.Errorf("got status %d; want 303", rr.Code)
+	}
+
+	user, err = tt.app.Users.GetUser(ctx, tt.user.ID)
+	if err != nil || user.TOTPEnabled {
+		t.Errorf("2FA is still on: %+v, %v", user, err)
+	}
+}
@@ -1,29 +1,70 @@
 package main
 
 import (
+	"encoding/json"
 	"html/template"
 	"net/http"
-	"path/filepath"
 	"time"
 
+	"snippetbox.org/pkg/i18n"
 	"snippetbox.org/pkg/models"
 	"bytes"
 	"github.com/justinas/nosurf"
 )
 
-func humanDate(t time.Time) string {
-	return t.Format("02 Jan 2006 at 15:04")
-}
-
 type HTMLData struct {
+	AuditActions []string
+	AuditEntries []*models.AuditEntry
+	BrowserZone string
+	CSPNonce string
 	CSRFToken string
 	Flash string
 	Form interface{}
+	Lang string
+	Locales []*i18n.Locale
+	Lockouts []*Lockout
 	LoggedIn bool
-	AdminLoggedIn bool
+	Pager *Pager
+	Passkeys []*models.Passkey
 	Path string
+	RecoveryCodes []string
+	Reports []*models.Report
+	ResolvedReports []*models.Report
+	Roles []string
 	Snippet *models.Snippet
+	SnippetHTML template.HTML
 	Snippets []*models.Snippet
+	SSO bool
+	TOTP *TOTPSetup
+	TrashDays int
+	User *models.User
+	Users []*models.User
+
+	locale *i18n.Locale
+	permissions map[string]bool
+	zone *time.Location
+}
+
+// Can reports whether the logged in user has the permission, so templates can
+// decide what to show with {{if .Can "snippets:delete"}}.
+func (d *HTMLData) Can(perm string) bool {
+	return d.permissions[perm]
+}
+
+// Date formats t in the user's language and timezone.
+func (d *HTMLData) Date(t time.Time) string {
+	return d.locale.Date(t.In(d.zone))
+}
+
+// Relative describes t relative to now in the user's language, such as "in 3
+// hours".
+func (d *HTMLData) Relative(t time.Time) string {
+	return d.locale.Relative(t, time.Now())
+}
+
+// Zone returns the name of the timezone dates are shown in.
+func (d *HTMLData) Zone() string {
+	return d.zone.String()
 }
 
 func (app *App) RenderHTML(w http.ResponseWriter, r *http.Request, page string, data *HTMLData) {
@@ -31,45 +72,56 @@ func (app *App) RenderHTML(w http.ResponseWriter, r *http.Request, page string,
 		data = &HTMLData{}
 	}
 
+	locale := app.Locale(r)
+	data.locale = locale
+	data.Lang = locale.Code
+	data.Locales = app.Locales.Locales()
 	data.Path = r.URL.Path
+	data.SSO = app.OIDC != nil
 
 	// Always add the CSRF token to the data for our templates.
 	data.CSRFToken = nosurf.Token(r)
+	data.CSPNonce = cspNonce(r)
 
-	var err error
-	data.LoggedIn, err = app.LoggedIn(r)
+	auth, err := app.auth(r)
 	if err != nil {
 		app.ServerError(w, err)
 		return
 	}
+	user := auth.user
+	data.LoggedIn = user != nil
+	data.permissions = auth.permissions
+
+	data.BrowserZone = app.browserZone(r)
+	data.zone = app.Timezone(r, user)
 
-	data.AdminLoggedIn, err = app.AdminLoggedIn(r)
+	ts, err := app.Templates.Lookup(locale, page)
 	if err != nil {
 		app.ServerError(w, err)
 		return
 	}
 
-	files := []string{
-		filepath.Join(app.HTMLDir, "base.html"),
-		filepath.Join(app.HTMLDir, page),
-	}
-
-	funcs := template.FuncMap{
-		"humanDate": humanDate,
-	}
-
-	ts, err := template.New("").Funcs(funcs).ParseFiles(files...)
+	buf := new(bytes.Buffer)
+	err = ts.ExecuteTemplate(buf, "base", data)
 	if err != nil {
 		app.ServerError(w, err)
 		return
 	}
 
-	buf := new(bytes.Buffer)
-	err = ts.ExecuteTemplate(buf, "base", data)
+	w.Header().Set("Content-Language", locale.Code)
+	buf.WriteTo(w)
+}
+
+
+// RenderJSON writes v as a JSON response with the given status code.
+func (app *App) RenderJSON(w http.ResponseWriter, status int, v interface{}) {
+	js, err := json.Marshal(v)
 	if err != nil {
 		app.ServerError(w, err)
 		return
 	}
 
-	buf.WriteTo(w)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(js)
 }
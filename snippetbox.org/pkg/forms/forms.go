@@ -1,7 +1,9 @@
 package forms
 
 import (
+	"strconv"
 	"strings"
+	"time"
 	"unicode/utf8"
 	"regexp"
 )
@@ -11,6 +13,7 @@ var rxEmail = regexp.MustCompile("^[a-zA-Z0-9.!#$%&'*+\\/=?^_`{|}~-]+@[a-zA-Z0-9
 type NewSnippet struct {
 	Title string
 	Content string
+	ContentType string
 	Expires string
 	Failures map[string]string
 }
@@ -28,6 +31,10 @@ func (f *NewSnippet) Valid() bool {
 		f.Failures["Content"] = "Content is required"
 	}
 
+	if f.ContentType != "text" && f.ContentType != "markdown" {
+		f.Failures["ContentType"] = "Format must be plain text or markdown"
+	}
+
 	permitted := map[string]bool{"3600": true, "86400": true, "31536000": true}
 	if strings.TrimSpace(f.Expires) == "" {
 		f.Failures["Expires"] = "Expiry time is required"
@@ -119,5 +126,119 @@ type DeleteSnippet struct {
 }
 
 func (f *DeleteSnippet) Valid() bool {
+	f.Failures = make(map[string]string)
+
+	if strings.TrimSpace(f.Id) == "" {
+		f.Failures["Id"] = "Id is required"
+	} else if id, err := strconv.Atoi(f.Id); err != nil || id < 1 {
+		f.Failures["Id"] = "Id must be a snippet number"
+	}
+
+	return len(f.Failures) == 0
+}
+type ReportSnippet struct {
+	Reason string
+	Failures map[string]string
+}
+
+func (f *ReportSnippet) Valid() bool {
+	f.Failures = make(map[string]string)
+
+	if strings.TrimSpace(f.Reason) == "" {
+		f.Failures["Reason"] = "Reason is required"
+	} else if utf8.RuneCountInString(f.Reason) > 500 {
+		f.Failures["Reason"] = "Reason cannot be longer than 500 characters"
+	}
+
+	return len(f.Failures) == 0
+}
+
+type ForgotPassword struct {
+	Email string
+	Failures map[string]string
+}
+
+func (f *ForgotPassword) Valid() bool {
+	f.Failures = make(map[string]string)
+
+	if strings.TrimSpace(f.Email) == "" {
+		f.Failures["Email"] = "Email is required"
+	} else if len(f.Email) > 254 || !rxEmail.MatchString(f.Email) {
+		f.Failures["Email"] = "Email is not a valid address"
+	}
+
+	return len(f.Failures) == 0
+}
+
+type ResetPassword struct {
+	Token string
+	Password string
+	Confirm string
+	Failures map[string]string
+}
+
+func (f *ResetPassword) Valid() bool {
+	f.Failures = make(map[string]string)
+
+	if utf8.RuneCountInString(f.Password) < 8 {
+		f.Failures["Password"] = "Password cannot be shorter than 8 characters"
+	}
+
+	if f.Confirm != f.Password {
+		f.Failures["Confirm"] = "Passwords do not match"
+	}
+
+	return len(f.Failures) == 0
+}
+
+type TwoFactorCode struct {
+	Code string
+	Failures map[string]string
+}
+
+func (f *TwoFactorCode) Valid() bool {
+	f.Failures = make(map[string]string)
+
+	if strings.TrimSpace(f.Code) == "" {
+		f.Failures["Code"] = "Code is required"
+	}
+
 	return len(f.Failures) == 0
-}
\ No newline at end of file
+}
+
+type AdminSettings struct {
+	RequireAdmin2FA bool
+	Failures map[string]string
+}
+
+func (f *AdminSettings) Valid() bool {
+	f.Failures = make(map[string]string)
+
+	return len(f.Failures) == 0
+}
+
+type Preferences struct {
+	Timezone string
+	Failures map[string]string
+}
+
+func (f *Preferences) Valid() bool {
+	f.Failures = make(map[string]string)
+
+	if f.Timezone != "" && !ValidTimezone(f.Timezone) {
+		f.Failures["Timezone"] = "Timezone is not a known zone"
+	}
+
+	return len(f.Failures) == 0
+}
+
+// ValidTimezone reports whether name is an IANA zone name, such as
+// Europe/London.
+func ValidTimezone(name string) bool {
+	if name == "" || name == "Local" || len(name) > 64 {
+		return false
+	}
+
+	_, err := time.LoadLocation(name)
+	return err == nil
+}
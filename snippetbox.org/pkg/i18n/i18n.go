@@ -0,0 +1,243 @@
+// Package i18n translates the user interface. Messages are looked up by their
+// English text, so code and templates read naturally and a missing
+// translation falls back to English.
+package i18n
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+// Locale is the catalog for one language.
+type Locale struct {
+	Code string
+	// Name is what the language calls itself, for the language picker.
+	Name string
+
+	tag        language.Tag
+	dateLayout string
+	months     []string
+	messages   map[string]string
+}
+
+// catalog is the format of a locale's JSON file.
+type catalog struct {
+	Name string `json:"name"`
+	// DateLayout is a time.Format layout. If Months is set, "Jan" in the
+	// layout is replaced by the month's name from it.
+	DateLayout string            `json:"date_layout"`
+	Months     []string          `json:"months"`
+	Messages   map[string]string `json:"messages"`
+}
+
+// T translates msg. If there are args, msg is a fmt format and the
+// translation is formatted with them.
+func (l *Locale) T(msg string, args ...interface{}) string {
+	if s, ok := l.messages[msg]; ok && s != "" {
+		msg = s
+	}
+	if len(args) > 0 {
+		return fmt.Sprintf(msg, args...)
+	}
+	return msg
+}
+
+// Date formats t the way the language writes dates.
+func (l *Locale) Date(t time.Time) string {
+	if len(l.months) != 12 {
+		return t.Format(l.dateLayout)
+	}
+
+	// A NUL byte isn't part of any layout element, so Format copies it
+	// through and it marks where the month name goes.
+	s := t.Format(strings.Replace(l.dateLayout, "Jan", "\x00", 1))
+	return strings.Replace(s, "\x00", l.months[t.Month()-1], 1)
+}
+
+// Relative describes t relative to now, such as "in 3 hours" or "2 days ago".
+func (l *Locale) Relative(t, now time.Time) string {
+	d := t.Sub(now)
+	future := d > 0
+	if d < 0 {
+		d = -d
+	}
+	if d < time.Minute {
+		return l.T("just now")
+	}
+
+	const day = 24 * time.Hour
+	var size time.Duration
+	var unit string
+	switch {
+	case d < time.Hour:
+		size, unit = time.Minute, "minute"
+	case d < day:
+		size, unit = time.Hour, "hour"
+	case d < 30*day:
+		size, unit = day, "day"
+	case d < 365*day:
+		size, unit = 30*day, "month"
+	default:
+		size, unit = 365*day, "year"
+	}
+	n := int((d + size/2) / size)
+
+	// Each phrase is a message of its own, as languages differ in how they
+	// put numbers, units and tense together.
+	switch {
+	case n == 1 && future:
+		return l.T("in 1 " + unit)
+	case n == 1:
+		return l.T("1 " + unit + " ago")
+	case future:
+		return l.T("in %d "+unit+"s", n)
+	default:
+		return l.T("%d "+unit+"s ago", n)
+	}
+}
+
+// Duration describes a length of time in its largest unit, rounded up to a
+// whole number of it, such as "45 seconds" or "30 minutes".
+func (l *Locale) Duration(d time.Duration) string {
+	var size time.Duration
+	var unit string
+	switch {
+	case d <= time.Minute:
+		size, unit = time.Second, "second"
+	case d <= time.Hour:
+		size, unit = time.Minute, "minute"
+	default:
+		size, unit = time.Hour, "hour"
+	}
+	n := int((d + size - 1) / size)
+
+	if n <= 1 {
+		return l.T("1 " + unit)
+	}
+	return l.T("%d "+unit+"s", n)
+}
+
+// Bundle is the set of languages the site is available in.
+type Bundle struct {
+	locales []*Locale
+	byCode  map[string]*Locale
+	matcher language.Matcher
+}
+
+var rxVerb = regexp.MustCompile(`%[-+# 0]*[0-9]*(?:\.[0-9]+)?[a-zA-Z%]`)
+
+// Load reads a <code>.json catalog for each language from fsys. fallback is
+// the code of the language to use when nothing better matches, and must be
+// one of them.
+func Load(fsys fs.FS, fallback string) (*Bundle, error) {
+	names, err := fs.Glob(fsys, "*.json")
+	if err != nil {
+		return nil, err
+	}
+
+	b := &Bundle{byCode: make(map[string]*Locale)}
+	for _, name := range names {
+		l, err := loadLocale(fsys, name)
+		if err != nil {
+			return nil, err
+		}
+
+		b.byCode[l.Code] = l
+		if l.Code == fallback {
+			b.locales = append([]*Locale{l}, b.locales...)
+		} else {
+			b.locales = append(b.locales, l)
+		}
+	}
+
+	if b.byCode[fallback] == nil {
+		return nil, fmt.Errorf("i18n: no catalog for the fallback language %q", fallback)
+	}
+
+	// The matcher falls back to the first tag, which is the fallback
+	// language.
+	tags := make([]language.Tag, len(b.locales))
+	for i, l := range b.locales {
+		tags[i] = l.tag
+	}
+	b.matcher = language.NewMatcher(tags)
+
+	return b, nil
+}
+
+func loadLocale(fsys fs.FS, name string) (*Locale, error) {
+	js, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var c catalog
+	err = json.Unmarshal(js, &c)
+	if err != nil {
+		return nil, fmt.Errorf("i18n: %s: %v", name, err)
+	}
+
+	code := strings.TrimSuffix(path.Base(name), ".json")
+	tag, err := language.Parse(code)
+	if err != nil {
+		return nil, fmt.Errorf("i18n: %s: %v", name, err)
+	}
+
+	if c.DateLayout == "" {
+		return nil, fmt.Errorf("i18n: %s: date_layout is required", name)
+	}
+	if c.Months != nil && len(c.Months) != 12 {
+		return nil, fmt.Errorf("i18n: %s: months must list all 12 months", name)
+	}
+
+	// A translation that takes different arguments to its message would
+	// render as garbage, so catch it when the catalog is loaded.
+	for msg, s := range c.Messages {
+		if s != "" && !reflect.DeepEqual(rxVerb.FindAllString(msg, -1), rxVerb.FindAllString(s, -1)) {
+			return nil, fmt.Errorf("i18n: %s: %q doesn't have the same format verbs as its message %q", name, s, msg)
+		}
+	}
+
+	return &Locale{
+		Code:       code,
+		Name:       c.Name,
+		tag:        tag,
+		dateLayout: c.DateLayout,
+		months:     c.Months,
+		messages:   c.Messages,
+	}, nil
+}
+
+// Locales returns every language, the fallback first.
+func (b *Bundle) Locales() []*Locale {
+	return b.locales
+}
+
+// Fallback returns the language used when nothing better matches.
+func (b *Bundle) Fallback() *Locale {
+	return b.locales[0]
+}
+
+// Lookup returns the language with the code, or nil if there isn't one.
+func (b *Bundle) Lookup(code string) *Locale {
+	return b.byCode[code]
+}
+
+// Match picks the best language for an Accept-Language header.
+func (b *Bundle) Match(acceptLanguage string) *Locale {
+	prefs, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(prefs) == 0 {
+		return b.Fallback()
+	}
+
+	_, i, _ := b.matcher.Match(prefs...)
+	return b.locales[i]
+}
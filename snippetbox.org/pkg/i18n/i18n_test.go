@@ -0,0 +1,42 @@
+package i18n
+
+import (
+	"testing"
+	"testing/fstest"
+	"time"
+)
+
+func TestDuration(t *testing.T) {
+	b, err := Load(fstest.MapFS{
+		"en.json": {Data: []byte(`{"name": "English", "date_layout": "2006-01-02"}`)},
+		"de.json": {Data: []byte(`{"name": "Deutsch", "date_layout": "02.01.2006", "messages": {
+			"1 second": "1 Sekunde", "%d seconds": "%d Sekunden",
+			"1 minute": "1 Minute", "%d minutes": "%d Minuten",
+			"1 hour": "1 Stunde", "%d hours": "%d Stunden"}}`)},
+	}, "en")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		d      time.Duration
+		wantEN string
+		wantDE string
+	}{
+		{500 * time.Millisecond, "1 second", "1 Sekunde"},
+		{47 * time.Second, "47 seconds", "47 Sekunden"},
+		{time.Minute, "60 seconds", "60 Sekunden"},
+		{29*time.Minute + 47*time.Second, "30 minutes", "30 Minuten"},
+		{time.Hour, "60 minutes", "60 Minuten"},
+		{time.Hour + time.Second, "2 hours", "2 Stunden"},
+	}
+
+	for _, tt := range tests {
+		if got := b.Lookup("en").Duration(tt.d); got != tt.wantEN {
+			t.Errorf("en Duration(%v) = %q; want %q", tt.d, got, tt.wantEN)
+		}
+		if got := b.Lookup("de").Duration(tt.d); got != tt.wantDE {
+			t.Errorf("de Duration(%v) = %q; want %q", tt.d, got, tt.wantDE)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package mailer
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"net"
+	"net/mail"
+	"net/smtp"
+)
+
+// Mailer is implemented by anything that can deliver a plain-text email. The
+// application only depends on this interface, so the delivery mechanism can be
+// swapped without touching the handlers.
+type Mailer interface {
+	Send(to, subject, body string) error
+}
+
+// SMTP sends email through an SMTP server. Username and Password are optional;
+// if Username is empty no authentication is attempted.
+type SMTP struct {
+	Addr     string
+	From     string
+	Username string
+	Password string
+}
+
+func (m *SMTP) Send(to, subject, body string) error {
+	var auth smtp.Auth
+	if m.Username != "" {
+		host, _, err := net.SplitHostPort(m.Addr)
+		if err != nil {
+			return err
+		}
+		auth = smtp.PlainAuth("", m.Username, m.Password, host)
+	}
+
+	// From may include a display name, but the SMTP envelope needs the bare
+	// address.
+	from, err := mail.ParseAddress(m.From)
+	if err != nil {
+		return err
+	}
+
+	msg := new(bytes.Buffer)
+	fmt.Fprintf(msg, "From: %s\r\n", m.From)
+	fmt.Fprintf(msg, "To: %s\r\n", to)
+	fmt.Fprintf(msg, "Subject: %s\r\n", subject)
+	fmt.Fprintf(msg, "Content-Type: text/plain; charset=utf-8\r\n\r\n")
+	msg.WriteString(body)
+
+	return smtp.SendMail(m.Addr, auth, from.Address, []string{to}, msg.Bytes())
+}
+
+// Log writes emails to the standard logger instead of sending them. It's meant
+// for development, when there's no SMTP server to hand.
+type Log struct{}
+
+func (m *Log) Send(to, subject, body string) error {
+	log.Printf("mail to %s: %s\n%s", to, subject, body)
+	return nil
+}
@@ -0,0 +1,141 @@
+// Package markdown renders markdown snippets to HTML that's safe to put in a
+// page.
+//
+// html/template escapes everything the templates print, but rendered markdown
+// has to be printed as template.HTML, which bypasses that. So the rendered
+// HTML is passed through a strict allow-list of elements and attributes
+// before it's marked safe, and Render is the only place that should happen.
+package markdown
+
+import (
+	"bytes"
+	"html/template"
+	"regexp"
+	"sync"
+
+	"github.com/alecthomas/chroma/v2"
+	chromahtml "github.com/alecthomas/chroma/v2/formatters/html"
+	"github.com/alecthomas/chroma/v2/lexers"
+	"github.com/alecthomas/chroma/v2/styles"
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/util"
+)
+
+// ClassPrefix starts the CSS class of every highlighted token, so the
+// sanitizer can tell highlighting classes from any others.
+const ClassPrefix = "hl-"
+
+var formatter = chromahtml.New(chromahtml.WithClasses(true), chromahtml.ClassPrefix(ClassPrefix))
+
+// style is only used to write the stylesheet; highlighted code is marked up
+// with classes, as the Content-Security-Policy doesn't allow inline styles.
+var style = styles.Get("github")
+
+// goldmark leaves out raw HTML unless it's told otherwise, but the sanitizer
+// doesn't rely on that.
+var md = goldmark.New(
+	goldmark.WithExtensions(
+		extension.NewTable(extension.WithTableCellAlignMethod(extension.TableCellAlignAttribute)),
+		extension.Strikethrough,
+		extension.Linkify,
+	),
+	goldmark.WithRendererOptions(
+		renderer.WithNodeRenderers(util.Prioritized(highlighter{}, 100)),
+	),
+)
+
+var policy = newPolicy()
+
+func newPolicy() *bluemonday.Policy {
+	p := bluemonday.NewPolicy()
+
+	p.AllowElements("p", "br", "hr", "h1", "h2", "h3", "h4", "h5", "h6", "blockquote", "ul", "ol", "li",
+		"strong", "em", "del", "code", "pre", "span", "table", "thead", "tbody", "tr", "th", "td")
+	p.AllowAttrs("start").Matching(bluemonday.Integer).OnElements("ol")
+	p.AllowAttrs("align").Matching(regexp.MustCompile(`^(left|center|right)$`)).OnElements("th", "td")
+	p.AllowAttrs("class").Matching(regexp.MustCompile(`^`+ClassPrefix+`[a-z0-9]+$`)).OnElements("pre", "span")
+
+	// Links may only go to other sites or email addresses, and don't pass
+	// on the page they were followed from.
+	p.AllowAttrs("href").OnElements("a")
+	p.AllowURLSchemes("http", "https", "mailto")
+	p.RequireParseableURLs(true)
+	p.RequireNoFollowOnLinks(true)
+	p.RequireNoReferrerOnLinks(true)
+
+	return p
+}
+
+// Render converts markdown to sanitized HTML.
+func Render(src string) (template.HTML, error) {
+	var buf bytes.Buffer
+	err := md.Convert([]byte(src), &buf)
+	if err != nil {
+		return "", err
+	}
+
+	return template.HTML(policy.SanitizeBytes(buf.Bytes())), nil
+}
+
+var (
+	cssOnce sync.Once
+	css     []byte
+	cssErr  error
+)
+
+// CSS returns the stylesheet for highlighted code.
+func CSS() ([]byte, error) {
+	cssOnce.Do(func() {
+		var buf bytes.Buffer
+		cssErr = formatter.WriteCSS(&buf, style)
+		css = buf.Bytes()
+	})
+	return css, cssErr
+}
+
+// highlighter renders fenced code blocks with syntax highlighting for the
+// language named after the opening fence.
+type highlighter struct{}
+
+func (h highlighter) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindFencedCodeBlock, h.renderFencedCodeBlock)
+}
+
+func (h highlighter) renderFencedCodeBlock(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkContinue, nil
+	}
+
+	n := node.(*ast.FencedCodeBlock)
+	var code bytes.Buffer
+	lines := n.Lines()
+	for i := 0; i < lines.Len(); i++ {
+		line := lines.At(i)
+		code.Write(line.Value(source))
+	}
+
+	// Guessing the language is often wrong, so code without one is shown
+	// plain.
+	lexer := lexers.Fallback
+	if lang := n.Language(source); lang != nil {
+		if l := lexers.Get(string(lang)); l != nil {
+			lexer = l
+		}
+	}
+
+	tokens, err := chroma.Coalesce(lexer).Tokenise(nil, code.String())
+	if err != nil {
+		return ast.WalkStop, err
+	}
+
+	err = formatter.Format(w, style, tokens)
+	if err != nil {
+		return ast.WalkStop, err
+	}
+
+	return ast.WalkSkipChildren, nil
+}
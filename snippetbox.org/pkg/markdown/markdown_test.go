@@ -0,0 +1,117 @@
+package markdown
+
+import (
+	"strings"
+	"testing"
+)
+
+// The policy is tested on raw HTML as well as through Render, as it mustn't
+// rely on goldmark leaving raw HTML out.
+func TestPolicy(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"Allowed elements", "<p><strong>a</strong> <em>b</em> <del>c</del></p>", "<p><strong>a</strong> <em>b</em> <del>c</del></p>"},
+		{"Script", `<p>a<script>alert(1)</script></p>`, "<p>a</p>"},
+		{"Style", `<style>p { color: red }</style><p>a</p>`, "<p>a</p>"},
+		{"Iframe", `<iframe src="https://example.com"></iframe>`, ""},
+		{"Image", `<img src="https://example.com/a.png">`, ""},
+		{"Event handler", `<p onclick="alert(1)">a</p>`, "<p>a</p>"},
+		{"Style attribute", `<span style="color: red">a</span>`, "<span>a</span>"},
+		{"Highlighting class", `<span class="hl-k">a</span>`, `<span class="hl-k">a</span>`},
+		{"Other class", `<span class="admin">a</span>`, "<span>a</span>"},
+		{"Class on other element", `<p class="hl-k">a</p>`, "<p>a</p>"},
+		{"List start", `<ol start="3"><li>a</li></ol>`, `<ol start="3"><li>a</li></ol>`},
+		{"Bad list start", `<ol start="x"><li>a</li></ol>`, "<ol><li>a</li></ol>"},
+		{"Cell alignment", `<table><tr><td align="center">a</td></tr></table>`, `<table><tr><td align="center">a</td></tr></table>`},
+		{"Bad cell alignment", `<table><tr><td align="justify">a</td></tr></table>`, "<table><tr><td>a</td></tr></table>"},
+		{"HTTPS link", `<a href="https://example.com">a</a>`, `<a href="https://example.com" rel="nofollow noreferrer">a</a>`},
+		{"Mailto link", `<a href="mailto:alice@example.com">a</a>`, `<a href="mailto:alice@example.com" rel="nofollow noreferrer">a</a>`},
+		{"JavaScript link", `<a href="javascript:alert(1)">a</a>`, "a"},
+		{"Data link", `<a href="data:text/html,hi">a</a>`, "a"},
+		{"Link target", `<a href="https://example.com" target="_blank">a</a>`, `<a href="https://example.com" rel="nofollow noreferrer">a</a>`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := policy.Sanitize(tt.in)
+			if got != tt.want {
+				t.Errorf("got %q; want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     string
+		want    []string
+		notWant []string
+	}{
+		{
+			name: "Emphasis",
+			src:  "*a* **b** ~~c~~",
+			want: []string{"<em>a</em>", "<strong>b</strong>", "<del>c</del>"},
+		},
+		{
+			name:    "Raw HTML",
+			src:     "<script>alert(1)</script>\n\n<b onclick=\"alert(1)\">a</b>",
+			notWant: []string{"<script", "onclick"},
+		},
+		{
+			name:    "JavaScript link",
+			src:     "[a](javascript:alert(1))",
+			notWant: []string{"javascript:", "href"},
+		},
+		{
+			name: "Autolink",
+			src:  "https://example.com",
+			want: []string{`<a href="https://example.com" rel="nofollow noreferrer">https://example.com</a>`},
+		},
+		{
+			name:    "Image",
+			src:     "![a](https://example.com/a.png)",
+			notWant: []string{"<img"},
+		},
+		{
+			name: "Table",
+			src:  "| a | b |\n|:-:|--:|\n| 1 | 2 |",
+			want: []string{`<th align="center">a</th>`, `<td align="right">2</td>`},
+		},
+		{
+			name:    "Highlighted code",
+			src:     "```go\nfunc main() {}\n```",
+			want:    []string{`<pre class="hl-chroma">`, `<span class="hl-kd">func</span>`},
+			notWant: []string{"style="},
+		},
+		{
+			name:    "Code without a language",
+			src:     "```\n<b>a</b>\n```",
+			want:    []string{"&lt;b&gt;a&lt;/b&gt;"},
+			notWant: []string{"<b>"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Render(tt.src)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, s := range tt.want {
+				if !strings.Contains(string(got), s) {
+					t.Errorf("got %q; want it to contain %q", got, s)
+				}
+			}
+			for _, s := range tt.notWant {
+				if strings.Contains(string(got), s) {
+					t.Errorf("got %q; want it not to contain %q", got, s)
+				}
+			}
+		})
+	}
+}
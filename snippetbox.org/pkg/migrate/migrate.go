@@ -0,0 +1,359 @@
+// Package migrate applies versioned changes to the database schema.
+//
+// Each migration is a pair of SQL files named after its version and what it
+// does, such as 0002_add_reports.up.sql and 0002_add_reports.down.sql. Up
+// applies the change and down reverts it. The versions applied so far are
+// recorded in the schema_migrations table.
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrLocked is returned when another migration run holds the lock for too
+// long.
+var ErrLocked = errors.New("migrate: another migration is in progress")
+
+type Migration struct {
+	Version int
+	Name    string
+	Up      string
+	Down    string
+}
+
+func (m *Migration) String() string {
+	return fmt.Sprintf("%04d_%s", m.Version, m.Name)
+}
+
+// State is whether a migration has been applied, and when.
+type State struct {
+	*Migration
+	Applied *time.Time
+}
+
+// Locker stops two migration runs changing the schema at the same time. Lock
+// blocks until the lock is free, or returns ErrLocked if it gives up waiting.
+// The lock belongs to the connection, so anything holding it must use that
+// connection throughout.
+type Locker interface {
+	Lock(ctx context.Context, conn *sql.Conn) error
+	Unlock(ctx context.Context, conn *sql.Conn) error
+}
+
+var rxFile = regexp.MustCompile(`^([0-9]+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+// Load reads the migrations in fsys, ordered by version. Every migration must
+// have both an up and a down file.
+func Load(fsys fs.FS) ([]*Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int]*Migration)
+	for _, entry := range entries {
+		match := rxFile.FindStringSubmatch(entry.Name())
+		if entry.IsDir() || match == nil {
+			continue
+		}
+
+		version, err := strconv.Atoi(match[1])
+		if err != nil {
+			return nil, err
+		}
+
+		m := byVersion[version]
+		if m == nil {
+			m = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = m
+		} else if m.Name != match[2] {
+			return nil, fmt.Errorf("migrate: version %d is used by both %s and %s", version, m.Name, match[2])
+		}
+
+		b, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		if match[3] == "up" {
+			m.Up = string(b)
+		} else {
+			m.Down = string(b)
+		}
+	}
+
+	migrations := make([]*Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" || m.Down == "" {
+			return nil, fmt.Errorf("migrate: %s needs both an up and a down file", m)
+		}
+		migrations = append(migrations, m)
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
+
+// Create writes empty up and down files to dir for a new migration, numbered
+// after the latest one there, and returns their paths.
+func Create(dir, name string) (string, string, error) {
+	if !regexp.MustCompile(`^[a-z0-9_]+$`).MatchString(name) {
+		return "", "", fmt.Errorf("migrate: name %q must be lowercase letters, digits and underscores", name)
+	}
+
+	migrations, err := Load(os.DirFS(dir))
+	if err != nil {
+		return "", "", err
+	}
+
+	version := 1
+	if len(migrations) > 0 {
+		version = migrations[len(migrations)-1].Version + 1
+	}
+
+	m := &Migration{Version: version, Name: name}
+	up := filepath.Join(dir, m.String()+".up.sql")
+	down := filepath.Join(dir, m.String()+".down.sql")
+
+	for _, path := range []string{up, down} {
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+		if err != nil {
+			return "", "", err
+		}
+
+		_, err = fmt.Fprintf(f, "-- %s\n", m)
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return "", "", err
+		}
+	}
+
+	return up, down, nil
+}
+
+// Migrator applies and reverts migrations on a database.
+type Migrator struct {
+	db         *sql.DB
+	migrations []*Migration
+	locker     Locker
+}
+
+func New(db *sql.DB, migrations []*Migration, locker Locker) *Migrator {
+	return &Migrator{db: db, migrations: migrations, locker: locker}
+}
+
+const createTable = `CREATE TABLE IF NOT EXISTS schema_migrations (
+    version BIGINT NOT NULL PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    applied DATETIME NOT NULL
+)`
+
+// Status returns every migration and whether it's been applied. It doesn't
+// take the lock, so it may be out of date by the time it returns.
+func (m *Migrator) Status(ctx context.Context) ([]*State, error) {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	return m.status(ctx, conn)
+}
+
+// Pending returns the migrations that haven't been applied yet.
+func (m *Migrator) Pending(ctx context.Context) ([]*Migration, error) {
+	states, err := m.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pending := []*Migration{}
+	for _, s := range states {
+		if s.Applied == nil {
+			pending = append(pending, s.Migration)
+		}
+	}
+
+	return pending, nil
+}
+
+func (m *Migrator) status(ctx context.Context, conn *sql.Conn) ([]*State, error) {
+	_, err := conn.ExecContext(ctx, createTable)
+	if err != nil {
+		return nil, err
+	}
+
+	states := make([]*State, len(m.migrations))
+	byVersion := make(map[int]*State)
+	for i, mig := range m.migrations {
+		states[i] = &State{Migration: mig}
+		byVersion[mig.Version] = states[i]
+	}
+
+	rows, err := conn.QueryContext(ctx, "SELECT version, applied FROM schema_migrations")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var version int
+		var applied time.Time
+		err := rows.Scan(&version, &applied)
+		if err != nil {
+			return nil, err
+		}
+
+		// A version this binary doesn't know about means the database is
+		// newer than the code, and it can't be reverted from here.
+		s := byVersion[version]
+		if s == nil {
+			return nil, fmt.Errorf("migrate: version %d is applied but has no migration", version)
+		}
+		s.Applied = &applied
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return states, nil
+}
+
+// Up applies every pending migration in order, and returns the ones it
+// applied. It stops at the first that fails.
+func (m *Migrator) Up(ctx context.Context) ([]*Migration, error) {
+	return m.run(ctx, func(states []*State) []*Migration {
+		todo := []*Migration{}
+		for _, s := range states {
+			if s.Applied == nil {
+				todo = append(todo, s.Migration)
+			}
+		}
+		return todo
+	}, true)
+}
+
+// Down reverts the latest n applied migrations, newest first, and returns the
+// ones it reverted.
+func (m *Migrator) Down(ctx context.Context, n int) ([]*Migration, error) {
+	return m.run(ctx, func(states []*State) []*Migration {
+		todo := []*Migration{}
+		for i := len(states) - 1; i >= 0 && len(todo) < n; i-- {
+			if states[i].Applied != nil {
+				todo = append(todo, states[i].Migration)
+			}
+		}
+		return todo
+	}, false)
+}
+
+// run takes the lock, works out which migrations to apply or revert from the
+// current state, and runs them each in a transaction. Databases such as MySQL
+// commit schema changes straight away, so a migration that fails part way
+// through can leave some of its changes behind.
+func (m *Migrator) run(ctx context.Context, choose func([]*State) []*Migration, up bool) ([]*Migration, error) {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	err = m.locker.Lock(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+	defer m.locker.Unlock(context.Background(), conn)
+
+	states, err := m.status(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+
+	done := []*Migration{}
+	for _, mig := range choose(states) {
+		err := m.apply(ctx, conn, mig, up)
+		if err != nil {
+			return done, err
+		}
+		done = append(done, mig)
+	}
+
+	return done, nil
+}
+
+func (m *Migrator) apply(ctx context.Context, conn *sql.Conn, mig *Migration, up bool) error {
+	script, direction := mig.Up, "up"
+	if !up {
+		script, direction = mig.Down, "down"
+	}
+
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, stmt := range Statements(script) {
+		_, err := tx.ExecContext(ctx, stmt)
+		if err != nil {
+			return fmt.Errorf("migrate: %s %s: %w", mig, direction, err)
+		}
+	}
+
+	if up {
+		_, err = tx.ExecContext(ctx, "INSERT INTO schema_migrations (version, name, applied) VALUES(?, ?, ?)",
+			mig.Version, mig.Name, time.Now().UTC().Truncate(time.Second))
+	} else {
+		_, err = tx.ExecContext(ctx, "DELETE FROM schema_migrations WHERE version = ?", mig.Version)
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Statements splits a migration into the statements to execute one at a time,
+// as not every driver accepts several at once. A statement ends with a
+// semicolon at the end of a line, and lines starting with -- are comments.
+func Statements(script string) []string {
+	stmts := []string{}
+	var stmt strings.Builder
+	for _, line := range strings.Split(script, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "--") {
+			continue
+		}
+
+		stmt.WriteString(line)
+		stmt.WriteString("\n")
+
+		if strings.HasSuffix(trimmed, ";") {
+			stmts = append(stmts, strings.TrimSuffix(strings.TrimSpace(stmt.String()), ";"))
+			stmt.Reset()
+		}
+	}
+
+	if s := strings.TrimSpace(stmt.String()); s != "" {
+		stmts = append(stmts, s)
+	}
+
+	return stmts
+}
@@ -0,0 +1,210 @@
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"testing/fstest"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   fstest.MapFS
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "Ordered by version",
+			files: fstest.MapFS{
+				"0002_add_b.up.sql":   {Data: []byte("B")},
+				"0002_add_b.down.sql": {Data: []byte("-B")},
+				"0001_add_a.up.sql":   {Data: []byte("A")},
+				"0001_add_a.down.sql": {Data: []byte("-A")},
+				"README.md":           {Data: []byte("ignored")},
+			},
+			want: []string{"0001_add_a", "0002_add_b"},
+		},
+		{
+			name: "Missing down",
+			files: fstest.MapFS{
+				"0001_add_a.up.sql": {Data: []byte("A")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Version used twice",
+			files: fstest.MapFS{
+				"0001_add_a.up.sql":   {Data: []byte("A")},
+				"0001_add_a.down.sql": {Data: []byte("-A")},
+				"0001_add_b.up.sql":   {Data: []byte("B")},
+				"0001_add_b.down.sql": {Data: []byte("-B")},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			migrations, err := Load(tt.files)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v; want error %v", err, tt.wantErr)
+			}
+
+			var got []string
+			for _, m := range migrations {
+				got = append(got, m.String())
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v; want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStatements(t *testing.T) {
+	tests := []struct {
+		name   string
+		script string
+		want   []string
+	}{
+		{"Empty", "", []string{}},
+		{"Comments only", "-- nothing to do\n\n", []string{}},
+		{"One", "SELECT 1;", []string{"SELECT 1"}},
+		{"Several lines", "CREATE TABLE t (\n    id INTEGER\n);\nDROP TABLE t;\n", []string{"CREATE TABLE t (\n    id INTEGER\n)", "DROP TABLE t"}},
+		{"No final semicolon", "SELECT 1;\nSELECT 2", []string{"SELECT 1", "SELECT 2"}},
+		{"Semicolon mid-line", "SELECT ';' AS s;", []string{"SELECT ';' AS s"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Statements(tt.script)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %q; want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	dir := t.TempDir()
+
+	for _, want := range []string{"0001_add_a", "0002_add_b"} {
+		up, down, err := Create(dir, want[5:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if up != filepath.Join(dir, want+".up.sql") || down != filepath.Join(dir, want+".down.sql") {
+			t.Errorf("got %s and %s; want %s files", up, down, want)
+		}
+	}
+
+	_, _, err := Create(dir, "Bad Name")
+	if err == nil {
+		t.Error("Create accepted a name with spaces and capitals")
+	}
+}
+
+// noLock is a Locker for a single test database nothing else is using.
+type noLock struct{}
+
+func (noLock) Lock(ctx context.Context, conn *sql.Conn) error   { return nil }
+func (noLock) Unlock(ctx context.Context, conn *sql.Conn) error { return nil }
+
+func TestUpAndDown(t *testing.T) {
+	ctx := context.Background()
+
+	db, err := sql.Open("sqlite3", filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	// A table made by hand before migrations, which the baseline must keep.
+	_, err = db.Exec("CREATE TABLE users (id INTEGER PRIMARY KEY, name TEXT NOT NULL)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = db.Exec("INSERT INTO users (name) VALUES ('Alice')")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	migrations := []*Migration{
+		{
+			Version: 1, Name: "baseline",
+			Up:   "CREATE TABLE IF NOT EXISTS users (id INTEGER PRIMARY KEY, name TEXT NOT NULL);",
+			Down: "-- kept",
+		},
+		{
+			Version: 2, Name: "add_email",
+			Up:   "ALTER TABLE users ADD COLUMN email TEXT NOT NULL DEFAULT '';",
+			Down: "ALTER TABLE users DROP COLUMN email;",
+		},
+		{
+			Version: 3, Name: "broken",
+			Up:   "CREATE TABLE snippets (id INTEGER PRIMARY KEY);\nNOT SQL;",
+			Down: "DROP TABLE snippets;",
+		},
+	}
+	m := New(db, migrations, noLock{})
+
+	done, err := m.Up(ctx)
+	if err == nil || len(done) != 2 {
+		t.Fatalf("Up: got %d applied and error %v; want 2 applied and an error", len(done), err)
+	}
+
+	// The failed migration's transaction is rolled back, so it's still pending
+	// and left nothing behind.
+	pending, err := m.Pending(ctx)
+	if err != nil || len(pending) != 1 || pending[0].Version != 3 {
+		t.Errorf("Pending: got %v, %v; want version 3", pending, err)
+	}
+	var n int
+	db.QueryRow("SELECT COUNT(*) FROM sqlite_master WHERE name = 'snippets'").Scan(&n)
+	if n != 0 {
+		t.Error("the failed migration left its table behind")
+	}
+
+	var email string
+	err = db.QueryRow("SELECT email FROM users WHERE name = 'Alice'").Scan(&email)
+	if err != nil {
+		t.Errorf("existing user after Up: %v", err)
+	}
+
+	done, err = m.Down(ctx, 5)
+	if err != nil || len(done) != 2 || done[0].Version != 2 {
+		t.Fatalf("Down: got %v, %v; want versions 2 and 1", done, err)
+	}
+
+	err = db.QueryRow("SELECT COUNT(*) FROM users").Scan(&n)
+	if err != nil || n != 1 {
+		t.Errorf("existing users after Down: got %d, %v; want 1, nil", n, err)
+	}
+}
+
+func TestUnknownVersion(t *testing.T) {
+	ctx := context.Background()
+
+	db, err := sql.Open("sqlite3", filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	newer := New(db, []*Migration{{Version: 1, Name: "a", Up: "SELECT 1;", Down: "SELECT 1;"}}, noLock{})
+	_, err = newer.Up(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	older := New(db, nil, noLock{})
+	_, err = older.Status(ctx)
+	if err == nil {
+		t.Error("Status accepted an applied version it has no migration for")
+	}
+}
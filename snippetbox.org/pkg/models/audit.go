@@ -0,0 +1,57 @@
+package models
+
+import (
+	"time"
+)
+
+// Actions recorded in the audit log.
+const (
+	AuditLogin           = "login"
+	AuditLoginFailed     = "login_failed"
+	AuditSignup          = "signup"
+	AuditAdminCreated    = "admin_created"
+	AuditSnippetDeleted  = "snippet_deleted"
+	AuditSnippetRestored = "snippet_restored"
+	AuditSnippetReported = "snippet_reported"
+	AuditReportResolved  = "report_resolved"
+	AuditSnippetUnhidden = "snippet_unhidden"
+	AuditRoleChanged     = "role_changed"
+	AuditUserDisabled    = "user_disabled"
+	AuditUserEnabled     = "user_enabled"
+	AuditUserLoggedOut   = "user_logged_out"
+	AuditPasswordReset   = "password_reset"
+	AuditForcedReset     = "password_reset_forced"
+	AuditLockoutCleared  = "lockout_cleared"
+	AuditSettingsChanged = "settings_changed"
+	AuditTwoFactorOn     = "2fa_enabled"
+	AuditTwoFactorOff    = "2fa_disabled"
+	AuditPasskeyCloned   = "passkey_cloned"
+)
+
+// AuditActions lists every action, for filtering the log.
+var AuditActions = []string{
+	AuditLogin, AuditLoginFailed, AuditSignup, AuditAdminCreated, AuditSnippetDeleted, AuditSnippetRestored,
+	AuditSnippetReported, AuditReportResolved, AuditSnippetUnhidden, AuditRoleChanged, AuditUserDisabled, AuditUserEnabled, AuditUserLoggedOut, AuditPasswordReset,
+	AuditForcedReset, AuditLockoutCleared, AuditSettingsChanged, AuditTwoFactorOn, AuditTwoFactorOff,
+	AuditPasskeyCloned,
+}
+
+type AuditEntry struct {
+	ID        int
+	Action    string
+	ActorID   int
+	ActorName string
+	Target    string
+	IP        string
+	UserAgent string
+	Created   time.Time
+}
+
+// AuditFilter narrows down the audit log. Zero values match everything.
+type AuditFilter struct {
+	Action string
+	Actor  string
+	Target string
+	From   time.Time
+	To     time.Time
+}
@@ -1,15 +1,137 @@
 package models
 
 import (
+	"errors"
 	"time"
+
+	"github.com/go-webauthn/webauthn/webauthn"
+)
+
+var (
+	ErrDuplicateEmail     = errors.New("models: email address already in use")
+	ErrInvalidCredentials = errors.New("models: invalid user credentials")
+	ErrInvalidResetToken  = errors.New("models: invalid or expired password reset token")
+	ErrUnknownRole        = errors.New("models: unknown role")
+
+	// ErrTimeout and ErrCanceled are returned by the stores made with
+	// SnippetsWithTimeout and UsersWithTimeout when a call is stopped, because
+	// it ran out of time or because its context was canceled.
+	ErrTimeout  = errors.New("models: database call timed out")
+	ErrCanceled = errors.New("models: database call canceled")
+)
+
+// Roles, from least to most privileged. New users get DefaultRole.
+const (
+	RoleViewer    = "viewer"
+	RoleAuthor    = "author"
+	RoleModerator = "moderator"
+	RoleAdmin     = "admin"
+
+	DefaultRole = RoleAuthor
+)
+
+// Permissions are granted to roles in the role_permissions table.
+const (
+	PermViewSnippets     = "snippets:view"
+	PermCreateSnippets   = "snippets:create"
+	PermDeleteSnippets   = "snippets:delete"
+	PermModerateSnippets = "snippets:moderate"
+	PermManageUsers      = "users:manage"
+	PermManageSettings   = "settings:manage"
+	PermViewAudit        = "audit:view"
+)
+
+// How a snippet's content is shown: as plain text, or rendered as markdown.
+const (
+	ContentText     = "text"
+	ContentMarkdown = "markdown"
 )
 
 type Snippet struct {
-	ID int
-	Title string
-	Content string
-	Created time.Time
-	Expires time.Time
+	ID          int
+	UserID      int
+	Title       string
+	Content     string
+	ContentType string
+	Created     time.Time
+	Expires     time.Time
+	Deleted     *time.Time
+	DeletedBy   int
+}
+
+type Snippets []*Snippet
+
+type User struct {
+	ID             int
+	Name           string
+	Email          string
+	Role           string
+	Permissions    map[string]bool
+	Disabled       bool
+	SessionVersion int
+	TOTPEnabled    bool
+	TOTPSecret     string
+	// Timezone is the IANA name of the zone the user wants dates shown in,
+	// or empty to use the one their browser reports.
+	Timezone  string
+	LastLogin *time.Time
+	Created   time.Time
+}
+
+// Can reports whether the user's role grants the permission.
+func (u *User) Can(perm string) bool {
+	return u.Permissions[perm]
+}
+
+// Outranks reports whether permissions a hold everything b does and more, so
+// that a user with a may act against a user with b, such as by suspending
+// them. Nobody outranks a user with the same permissions.
+func Outranks(a, b map[string]bool) bool {
+	for perm, granted := range b {
+		if granted && !a[perm] {
+			return false
+		}
+	}
+
+	for perm, granted := range a {
+		if granted && !b[perm] {
+			return true
+		}
+	}
+	return false
+}
+
+type Passkey struct {
+	ID         int
+	UserID     int
+	Name       string
+	Credential webauthn.Credential
+	Created    time.Time
+	LastUsed   *time.Time
 }
 
-type Snippets []*Snippet
\ No newline at end of file
+// Report statuses. Every report starts open, and is closed by a moderator's
+// decision.
+const (
+	ReportOpen      = "open"
+	ReportDismissed = "dismissed"
+	ReportHidden    = "hidden"
+	ReportDeleted   = "deleted"
+)
+
+// Report is a user's complaint about a snippet, waiting in or resolved from the
+// moderation queue.
+type Report struct {
+	ID             int
+	SnippetID      int
+	SnippetTitle   string
+	SnippetContent string
+	AuthorID       int
+	AuthorName     string
+	ReporterName   string
+	Reason         string
+	Status         string
+	ModeratorName  string
+	Created        time.Time
+	Resolved       *time.Time
+}
@@ -0,0 +1,38 @@
+package models
+
+import "testing"
+
+func TestOutranks(t *testing.T) {
+	author := map[string]bool{PermViewSnippets: true, PermCreateSnippets: true}
+	moderator := map[string]bool{PermViewSnippets: true, PermCreateSnippets: true, PermDeleteSnippets: true,
+		PermModerateSnippets: true}
+	admin := map[string]bool{PermViewSnippets: true, PermCreateSnippets: true, PermDeleteSnippets: true,
+		PermModerateSnippets: true, PermManageUsers: true}
+	// A custom role that can manage users but not moderate.
+	manager := map[string]bool{PermViewSnippets: true, PermManageUsers: true}
+
+	tests := []struct {
+		name string
+		a, b map[string]bool
+		want bool
+	}{
+		{"Moderator over author", moderator, author, true},
+		{"Admin over moderator", admin, moderator, true},
+		{"Author over moderator", author, moderator, false},
+		{"Moderator over moderator", moderator, moderator, false},
+		{"Moderator over admin", moderator, admin, false},
+		{"Moderator over manager", moderator, manager, false},
+		{"Manager over author", manager, author, false},
+		{"Denied permissions don't count", map[string]bool{PermModerateSnippets: true, PermManageUsers: false},
+			map[string]bool{PermManageUsers: false}, true},
+		{"Author over nobody", author, map[string]bool{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Outranks(tt.a, tt.b); got != tt.want {
+				t.Errorf("got %v; want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+// Package mysql stores snippets and users in MySQL.
+package mysql
+
+import (
+	"database/sql"
+
+	"github.com/go-sql-driver/mysql"
+
+	"snippetbox.org/pkg/models/sqlstore"
+)
+
+type Database struct {
+	*sqlstore.Store
+}
+
+var dialect = sqlstore.Dialect{
+	IsDuplicate: isDuplicate,
+	Upsert: func(key, column string) string {
+		return "ON DUPLICATE KEY UPDATE " + column + " = VALUES(" + column + ")"
+	},
+	ForUpdate: " FOR UPDATE",
+	Backslash: `'\\'`,
+}
+
+// New returns a Database using db, which must be opened with parseTime=true.
+func New(db *sql.DB) *Database {
+	return &Database{sqlstore.New(db, dialect)}
+}
+
+// isDuplicate reports whether err is MySQL's duplicate key error.
+func isDuplicate(err error) bool {
+	mysqlErr, ok := err.(*mysql.MySQLError)
+	return ok && mysqlErr.Number == 1062
+}
@@ -0,0 +1,53 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"io/fs"
+
+	"snippetbox.org/pkg/migrate"
+)
+
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+// Migrator returns a migrator for the database's schema.
+func (db *Database) Migrator() (*migrate.Migrator, error) {
+	fsys, err := fs.Sub(migrationFiles, "migrations")
+	if err != nil {
+		return nil, err
+	}
+
+	migrations, err := migrate.Load(fsys)
+	if err != nil {
+		return nil, err
+	}
+
+	return migrate.New(db.DB, migrations, locker{}), nil
+}
+
+// locker takes a named lock with GET_LOCK. Lock names are shared by the whole
+// server, so the name includes the database's, and the lock is released if the
+// connection is lost.
+type locker struct{}
+
+const lockName = `CONCAT('snippetbox_migrate.', DATABASE())`
+
+func (locker) Lock(ctx context.Context, conn *sql.Conn) error {
+	var locked sql.NullInt64
+	err := conn.QueryRowContext(ctx, "SELECT GET_LOCK("+lockName+", 30)").Scan(&locked)
+	if err != nil {
+		return err
+	}
+	if locked.Int64 != 1 {
+		return migrate.ErrLocked
+	}
+
+	return nil
+}
+
+func (locker) Unlock(ctx context.Context, conn *sql.Conn) error {
+	_, err := conn.ExecContext(ctx, "DO RELEASE_LOCK("+lockName+")")
+	return err
+}
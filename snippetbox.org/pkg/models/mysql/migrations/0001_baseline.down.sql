@@ -0,0 +1,3 @@
+-- The baseline tables may hold data from before migrations, which reverting
+-- mustn't throw away, so they're left in place. Drop them by hand to start
+-- again from nothing.
@@ -0,0 +1,23 @@
+-- The schema as it was before migrations, when the tables were created by
+-- hand. Databases set up then already have these tables, so this only creates
+-- them if they're missing, and the migrations after it bring either kind of
+-- database up to date.
+
+CREATE TABLE IF NOT EXISTS snippets (
+    id INTEGER NOT NULL PRIMARY KEY AUTO_INCREMENT,
+    title VARCHAR(100) NOT NULL,
+    content TEXT NOT NULL,
+    created DATETIME NOT NULL,
+    expires DATETIME NOT NULL,
+    INDEX idx_snippets_created (created)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
+
+CREATE TABLE IF NOT EXISTS users (
+    id INTEGER NOT NULL PRIMARY KEY AUTO_INCREMENT,
+    name VARCHAR(255) NOT NULL,
+    email VARCHAR(255) NOT NULL,
+    password CHAR(60) NOT NULL,
+    admin BOOLEAN NOT NULL DEFAULT FALSE,
+    created DATETIME NOT NULL,
+    CONSTRAINT users_uc_email UNIQUE (email)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
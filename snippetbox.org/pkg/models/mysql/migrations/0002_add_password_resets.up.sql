@@ -0,0 +1,12 @@
+ALTER TABLE users ADD COLUMN session_version INTEGER NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS password_resets (
+    id INTEGER NOT NULL PRIMARY KEY AUTO_INCREMENT,
+    user_id INTEGER NOT NULL,
+    token_hash CHAR(64) NOT NULL,
+    created DATETIME NOT NULL,
+    expires DATETIME NOT NULL,
+    used DATETIME NULL,
+    CONSTRAINT password_resets_uc_token_hash UNIQUE (token_hash),
+    INDEX idx_password_resets_user_id (user_id)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS webauthn_credentials (
+    id INTEGER NOT NULL PRIMARY KEY AUTO_INCREMENT,
+    user_id INTEGER NOT NULL,
+    credential_id VARBINARY(1023) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    credential BLOB NOT NULL,
+    created DATETIME NOT NULL,
+    last_used DATETIME NULL,
+    CONSTRAINT webauthn_credentials_uc_credential_id UNIQUE (credential_id),
+    INDEX idx_webauthn_credentials_user_id (user_id)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
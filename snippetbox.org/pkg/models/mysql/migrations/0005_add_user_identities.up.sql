@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS user_identities (
+    user_id INTEGER NOT NULL,
+    issuer VARCHAR(255) NOT NULL,
+    subject VARCHAR(255) NOT NULL,
+    created DATETIME NOT NULL,
+    PRIMARY KEY (issuer, subject),
+    INDEX idx_user_identities_user_id (user_id)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
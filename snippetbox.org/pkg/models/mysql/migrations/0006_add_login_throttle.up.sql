@@ -0,0 +1,10 @@
+-- Used by the throttle MySQL store, so that every instance shares the same
+-- counts.
+
+CREATE TABLE IF NOT EXISTS login_throttle (
+    throttle_key VARCHAR(255) NOT NULL PRIMARY KEY,
+    failures INTEGER NOT NULL,
+    last_failure DATETIME NOT NULL,
+    locked_until DATETIME NULL,
+    INDEX idx_login_throttle_locked_until (locked_until)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
@@ -0,0 +1,35 @@
+CREATE TABLE IF NOT EXISTS roles (
+    name VARCHAR(32) NOT NULL PRIMARY KEY,
+    level INTEGER NOT NULL
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
+
+CREATE TABLE IF NOT EXISTS role_permissions (
+    role VARCHAR(32) NOT NULL,
+    permission VARCHAR(64) NOT NULL,
+    PRIMARY KEY (role, permission),
+    FOREIGN KEY (role) REFERENCES roles (name)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
+
+INSERT IGNORE INTO roles (name, level) VALUES
+    ('viewer', 1),
+    ('author', 2),
+    ('moderator', 3),
+    ('admin', 4);
+
+INSERT IGNORE INTO role_permissions (role, permission) VALUES
+    ('viewer', 'snippets:view'),
+    ('author', 'snippets:view'),
+    ('author', 'snippets:create'),
+    ('moderator', 'snippets:view'),
+    ('moderator', 'snippets:create'),
+    ('moderator', 'snippets:delete'),
+    ('moderator', 'snippets:moderate'),
+    ('admin', 'snippets:view'),
+    ('admin', 'snippets:create'),
+    ('admin', 'snippets:delete'),
+    ('admin', 'snippets:moderate'),
+    ('admin', 'users:manage'),
+    ('admin', 'settings:manage'),
+    ('admin', 'audit:view');
+
+ALTER TABLE users ADD COLUMN role VARCHAR(32) NOT NULL DEFAULT 'author';
@@ -0,0 +1,2 @@
+ALTER TABLE snippets DROP INDEX idx_snippets_user_id, DROP COLUMN user_id;
+ALTER TABLE users DROP COLUMN last_login, DROP COLUMN disabled;
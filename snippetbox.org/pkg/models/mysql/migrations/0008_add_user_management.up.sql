@@ -0,0 +1,8 @@
+ALTER TABLE users
+    ADD COLUMN disabled BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN last_login DATETIME NULL;
+
+-- Snippets from before this have no author.
+ALTER TABLE snippets
+    ADD COLUMN user_id INTEGER NULL,
+    ADD INDEX idx_snippets_user_id (user_id);
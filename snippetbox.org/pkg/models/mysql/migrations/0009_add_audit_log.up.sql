@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS audit_log (
+    id INTEGER NOT NULL PRIMARY KEY AUTO_INCREMENT,
+    action VARCHAR(64) NOT NULL,
+    actor_id INTEGER NULL,
+    target VARCHAR(255) NOT NULL,
+    ip VARCHAR(45) NOT NULL,
+    user_agent VARCHAR(255) NOT NULL,
+    created DATETIME NOT NULL,
+    INDEX idx_audit_log_created (created)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
@@ -0,0 +1 @@
+ALTER TABLE snippets DROP INDEX idx_snippets_deleted_at, DROP COLUMN deleted_by, DROP COLUMN deleted_at;
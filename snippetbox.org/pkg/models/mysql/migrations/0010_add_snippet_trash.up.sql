@@ -0,0 +1,4 @@
+ALTER TABLE snippets
+    ADD COLUMN deleted_at DATETIME NULL,
+    ADD COLUMN deleted_by INTEGER NULL,
+    ADD INDEX idx_snippets_deleted_at (deleted_at);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS reports;
+ALTER TABLE snippets DROP COLUMN hidden;
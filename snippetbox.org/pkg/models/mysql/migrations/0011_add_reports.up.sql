@@ -0,0 +1,15 @@
+ALTER TABLE snippets ADD COLUMN hidden BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE IF NOT EXISTS reports (
+    id INTEGER NOT NULL PRIMARY KEY AUTO_INCREMENT,
+    snippet_id INTEGER NOT NULL,
+    reporter_id INTEGER NOT NULL,
+    reason VARCHAR(500) NOT NULL,
+    status VARCHAR(16) NOT NULL,
+    moderator_id INTEGER NULL,
+    created DATETIME NOT NULL,
+    resolved_at DATETIME NULL,
+    CONSTRAINT reports_uc_snippet_reporter UNIQUE (snippet_id, reporter_id),
+    INDEX idx_reports_status (status),
+    FOREIGN KEY (snippet_id) REFERENCES snippets (id) ON DELETE CASCADE
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
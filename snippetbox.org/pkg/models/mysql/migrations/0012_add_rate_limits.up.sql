@@ -0,0 +1,10 @@
+-- Used by the ratelimit MySQL store, so that every instance shares the same
+-- buckets.
+
+CREATE TABLE IF NOT EXISTS rate_limits (
+    bucket_key VARCHAR(255) NOT NULL PRIMARY KEY,
+    tokens DOUBLE NOT NULL,
+    updated DATETIME(6) NOT NULL,
+    full_at DATETIME(6) NOT NULL,
+    INDEX idx_rate_limits_full_at (full_at)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
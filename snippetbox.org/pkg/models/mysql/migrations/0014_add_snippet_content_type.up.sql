@@ -0,0 +1 @@
+ALTER TABLE snippets ADD COLUMN content_type VARCHAR(16) NOT NULL DEFAULT 'text';
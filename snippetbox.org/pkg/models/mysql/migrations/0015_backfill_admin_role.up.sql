@@ -0,0 +1,6 @@
+-- Databases from before roles marked admins with the admin flag. Give them the
+-- admin role in its place; everybody else keeps the default role.
+
+UPDATE users SET role = 'admin' WHERE admin = 1;
+
+ALTER TABLE users DROP COLUMN admin;
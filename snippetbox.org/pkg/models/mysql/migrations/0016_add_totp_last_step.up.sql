@@ -0,0 +1,4 @@
+-- The time step of the last TOTP code each user logged in with, so that a
+-- code can't be used again.
+
+ALTER TABLE users ADD COLUMN totp_last_step BIGINT NULL;
@@ -0,0 +1,10 @@
+-- WebAuthn challenges waiting for an answer. They're kept here rather than in
+-- the session cookie, which a browser could send again, so that each one can
+-- only be answered once.
+
+CREATE TABLE IF NOT EXISTS webauthn_ceremonies (
+    challenge VARCHAR(255) NOT NULL PRIMARY KEY,
+    data BLOB NOT NULL,
+    expires DATETIME NOT NULL,
+    INDEX idx_webauthn_ceremonies_expires (expires)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
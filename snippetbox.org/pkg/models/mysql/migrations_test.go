@@ -0,0 +1,28 @@
+package mysql
+
+import (
+	"io/fs"
+	"testing"
+
+	"snippetbox.org/pkg/migrate"
+)
+
+// The migrations can only be run against a MySQL server, but they can at least
+// be checked to load and to be numbered without gaps.
+func TestMigrationsLoad(t *testing.T) {
+	fsys, err := fs.Sub(migrationFiles, "migrations")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	migrations, err := migrate.Load(fsys)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, m := range migrations {
+		if m.Version != i+1 {
+			t.Errorf("migration %s is number %d", m, i+1)
+		}
+	}
+}
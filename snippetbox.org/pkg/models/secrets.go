@@ -0,0 +1,83 @@
+package models
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// The secrets here are generated and checked the same way whichever database
+// they're stored in.
+
+// HashPassword returns the bcrypt hash of a password.
+func HashPassword(password string) ([]byte, error) {
+	return bcrypt.GenerateFromPassword([]byte(password), 12)
+}
+
+// CheckPassword compares a password with its hash, returning
+// ErrInvalidCredentials if they don't match.
+func CheckPassword(hashedPassword []byte, password string) error {
+	err := bcrypt.CompareHashAndPassword(hashedPassword, []byte(password))
+	if err == bcrypt.ErrMismatchedHashAndPassword {
+		return ErrInvalidCredentials
+	}
+	return err
+}
+
+// UnusablePassword returns the hash of a random password that nobody knows,
+// for accounts that can only be got into with a password reset.
+func UnusablePassword() ([]byte, error) {
+	password := make([]byte, 32)
+	_, err := rand.Read(password)
+	if err != nil {
+		return nil, err
+	}
+
+	return bcrypt.GenerateFromPassword(password, 12)
+}
+
+// NewResetToken returns a random URL-safe token along with the SHA-256 hash of
+// it. Only the hash is ever written to the database, so a leaked password_resets
+// table can't be used to take over accounts.
+func NewResetToken() (string, string, error) {
+	b := make([]byte, 32)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", "", err
+	}
+
+	token := base64.RawURLEncoding.EncodeToString(b)
+	return token, HashResetToken(token), nil
+}
+
+func HashResetToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// How many one-time recovery codes a user gets when they enable 2FA.
+const RecoveryCodeCount = 10
+
+// NewRecoveryCode returns a random code in the form xxxxx-xxxxx, using lowercase
+// hex digits so it's easy to read back and type.
+func NewRecoveryCode() (string, error) {
+	b := make([]byte, 5)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+
+	h := hex.EncodeToString(b)
+	return fmt.Sprintf("%s-%s", h[:5], h[5:]), nil
+}
+
+func HashRecoveryCode(code string) string {
+	code = strings.ToLower(strings.TrimSpace(code))
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,56 @@
+// Package sqlite stores snippets and users in an SQLite database file, so the
+// application can run without a MySQL server.
+package sqlite
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/mattn/go-sqlite3"
+
+	"snippetbox.org/pkg/models/sqlstore"
+)
+
+type Database struct {
+	*sqlstore.Store
+}
+
+var dialect = sqlstore.Dialect{
+	IsDuplicate: isDuplicate,
+	Upsert: func(key, column string) string {
+		return "ON CONFLICT (" + key + ") DO UPDATE SET " + column + " = excluded." + column
+	},
+	// SQLite can't lock rows, but the single connection Open allows means
+	// nothing else can write until the transaction commits.
+	ForUpdate: "",
+	Backslash: `'\'`,
+}
+
+// Open opens the database file at path, creating it if it doesn't exist yet.
+// Its tables are created by the migrations. A path of ":memory:" gives a
+// database that only lasts as long as the process.
+func Open(path string) (*Database, error) {
+	db, err := sql.Open("sqlite3", "file:"+path+"?_foreign_keys=on")
+	if err != nil {
+		return nil, err
+	}
+
+	// SQLite only allows one writer at a time, so a single connection avoids
+	// "database is locked" errors. It also means every query sees the same
+	// in-memory database.
+	db.SetMaxOpenConns(1)
+
+	err = db.Ping()
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return &Database{sqlstore.New(db, dialect)}, nil
+}
+
+// isDuplicate reports whether err is a unique constraint failing.
+func isDuplicate(err error) bool {
+	var sqliteErr sqlite3.Error
+	return errors.As(err, &sqliteErr) && sqliteErr.ExtendedCode == sqlite3.ErrConstraintUnique
+}
@@ -0,0 +1,300 @@
+package sqlite
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"snippetbox.org/pkg/models"
+)
+
+// newTestDatabase opens a fresh database file with every migration applied.
+func newTestDatabase(t *testing.T) *Database {
+	t.Helper()
+
+	db, err := Open(filepath.Join(t.TempDir(), "snippetbox.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	m, err := db.Migrator()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = m.Up(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return db
+}
+
+func TestUsers(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDatabase(t)
+
+	err := db.InsertUser(ctx, "Alice", "alice@example.com", "correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = db.InsertUser(ctx, "Alice again", "alice@example.com", "battery staple")
+	if err != models.ErrDuplicateEmail {
+		t.Errorf("inserting a duplicate email: got %v; want ErrDuplicateEmail", err)
+	}
+
+	tests := []struct {
+		name     string
+		email    string
+		password string
+		wantErr  error
+	}{
+		{"Valid", "alice@example.com", "correct horse", nil},
+		{"Wrong password", "alice@example.com", "battery staple", models.ErrInvalidCredentials},
+		{"Unknown email", "bob@example.com", "correct horse", models.ErrInvalidCredentials},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := db.VerifyUser(ctx, tt.email, tt.password)
+			if err != tt.wantErr {
+				t.Errorf("got %v; want %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	u, err := db.GetUserByEmail(ctx, "alice@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Role != models.DefaultRole || !u.Permissions[models.PermCreateSnippets] {
+		t.Errorf("new user has role %q and permissions %v", u.Role, u.Permissions)
+	}
+
+	u, err = db.GetUser(ctx, 999)
+	if err != nil || u != nil {
+		t.Errorf("GetUser for an unknown ID: got %v, %v; want nil, nil", u, err)
+	}
+}
+
+func TestSnippetsAndTrash(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDatabase(t)
+
+	err := db.InsertUser(ctx, "Alice", "alice@example.com", "correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	id, err := db.InsertSnippet(ctx, 1, "Title", "Content", models.ContentText, "3600")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := db.GetSnippet(ctx, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s == nil || s.Title != "Title" || !s.Expires.After(time.Now()) {
+		t.Fatalf("GetSnippet returned %+v", s)
+	}
+
+	err = db.InsertUser(ctx, "Moderator", "mod@example.com", "correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The moderator, user 2, deletes Alice's snippet.
+	err = db.DeleteSnippet(ctx, id, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s, err = db.GetSnippet(ctx, id)
+	if err != nil || s != nil {
+		t.Errorf("GetSnippet after deleting: got %v, %v; want nil, nil", s, err)
+	}
+
+	tests := []struct {
+		name  string
+		owner int
+		want  int
+	}{
+		{"Everyone", 0, 1},
+		{"Owner", 1, 0},
+		{"Deleter", 2, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			trash, err := db.TrashedSnippets(ctx, tt.owner, 30)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(trash) != tt.want {
+				t.Errorf("got %d trashed snippets; want %d", len(trash), tt.want)
+			}
+		})
+	}
+
+	restored, err := db.RestoreSnippet(ctx, id, 2, 30)
+	if err != nil || restored {
+		t.Errorf("RestoreSnippet by someone else: got %v, %v; want false, nil", restored, err)
+	}
+
+	// The owner can't undo a moderator's decision.
+	restored, err = db.RestoreSnippet(ctx, id, 1, 30)
+	if err != nil || restored {
+		t.Errorf("RestoreSnippet by the owner: got %v, %v; want false, nil", restored, err)
+	}
+
+	restored, err = db.RestoreSnippet(ctx, id, 0, 30)
+	if err != nil || !restored {
+		t.Errorf("RestoreSnippet by a moderator: got %v, %v; want true, nil", restored, err)
+	}
+
+	// But they can restore snippets they deleted themselves.
+	err = db.DeleteSnippet(ctx, id, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	trash, err := db.TrashedSnippets(ctx, 1, 30)
+	if err != nil || len(trash) != 1 {
+		t.Errorf("TrashedSnippets for the owner: got %d snippets, %v; want 1, nil", len(trash), err)
+	}
+
+	restored, err = db.RestoreSnippet(ctx, id, 1, 30)
+	if err != nil || !restored {
+		t.Errorf("RestoreSnippet by the owner: got %v, %v; want true, nil", restored, err)
+	}
+}
+
+func TestPasswordReset(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDatabase(t)
+
+	err := db.InsertUser(ctx, "Alice", "alice@example.com", "correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	u, token, err := db.InsertPasswordReset(ctx, "alice@example.com", time.Hour)
+	if err != nil || u == nil {
+		t.Fatalf("InsertPasswordReset: got %v, %v", u, err)
+	}
+
+	_, err = db.ResetPassword(ctx, token, "battery staple")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = db.ResetPassword(ctx, token, "battery staple")
+	if err != models.ErrInvalidResetToken {
+		t.Errorf("reusing a token: got %v; want ErrInvalidResetToken", err)
+	}
+
+	_, err = db.VerifyUser(ctx, "alice@example.com", "battery staple")
+	if err != nil {
+		t.Errorf("logging in with the new password: %v", err)
+	}
+}
+
+func TestRequireAdmin2FA(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDatabase(t)
+
+	// Setting it twice checks the upsert replaces the existing row.
+	for _, want := range []bool{true, false, true} {
+		err := db.SetRequireAdmin2FA(ctx, want)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := db.RequireAdmin2FA(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Errorf("got %v; want %v", got, want)
+		}
+	}
+}
+
+func TestSearchUsers(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDatabase(t)
+
+	for _, name := range []string{"Alice", "Bob_Smith", "Carol 100%", `Dan\Dee`} {
+		err := db.InsertUser(ctx, name, strings.ToLower(name[:3])+"@example.com", "correct horse")
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		query string
+		want  int
+	}{
+		{"", 4},
+		{"ali", 1},
+		{"_", 1},
+		{"%", 1},
+		{`\`, 1},
+		{"a_i", 0},
+		{"nobody", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			users, total, err := db.SearchUsers(ctx, tt.query, 0, 10)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if total != tt.want || len(users) != tt.want {
+				t.Errorf("got %d users of %d; want %d", len(users), total, tt.want)
+			}
+		})
+	}
+}
+
+func TestAuditEntries(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDatabase(t)
+
+	for _, target := range []string{"snippet 1", "snippet_2", "100% off"} {
+		err := db.InsertAudit(ctx, &models.AuditEntry{Action: models.AuditSnippetDeleted, Target: target})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name string
+		f    *models.AuditFilter
+		want int
+	}{
+		{"Everything", &models.AuditFilter{}, 3},
+		{"Action", &models.AuditFilter{Action: models.AuditLogin}, 0},
+		{"Target", &models.AuditFilter{Target: "snippet"}, 2},
+		{"Underscore", &models.AuditFilter{Target: "_"}, 1},
+		{"Percent", &models.AuditFilter{Target: "%"}, 1},
+		{"Future", &models.AuditFilter{From: time.Now().Add(time.Hour)}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entries, total, err := db.AuditEntries(ctx, tt.f, 0, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if total != tt.want || len(entries) != tt.want {
+				t.Errorf("got %d entries of %d; want %d", len(entries), total, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"io/fs"
+
+	"github.com/mattn/go-sqlite3"
+
+	"snippetbox.org/pkg/migrate"
+)
+
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+// Migrator returns a migrator for the database's schema.
+func (db *Database) Migrator() (*migrate.Migrator, error) {
+	fsys, err := fs.Sub(migrationFiles, "migrations")
+	if err != nil {
+		return nil, err
+	}
+
+	migrations, err := migrate.Load(fsys)
+	if err != nil {
+		return nil, err
+	}
+
+	return migrate.New(db.DB, migrations, locker{}), nil
+}
+
+// locker locks the database file. In exclusive locking mode SQLite never gives
+// up a lock it's taken, so the exclusive lock from an empty transaction is
+// held, keeping out every other process, until the mode is changed back.
+type locker struct{}
+
+func (locker) Lock(ctx context.Context, conn *sql.Conn) error {
+	_, err := conn.ExecContext(ctx, "PRAGMA locking_mode = EXCLUSIVE")
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.ExecContext(ctx, "BEGIN EXCLUSIVE")
+	var sqliteErr sqlite3.Error
+	if errors.As(err, &sqliteErr) && sqliteErr.Code == sqlite3.ErrBusy {
+		conn.ExecContext(ctx, "PRAGMA locking_mode = NORMAL")
+		return migrate.ErrLocked
+	} else if err != nil {
+		return err
+	}
+
+	_, err = conn.ExecContext(ctx, "COMMIT")
+	return err
+}
+
+func (locker) Unlock(ctx context.Context, conn *sql.Conn) error {
+	_, err := conn.ExecContext(ctx, "PRAGMA locking_mode = NORMAL")
+	if err != nil {
+		return err
+	}
+
+	// The lock is only released the next time the file is read.
+	_, err = conn.ExecContext(ctx, "SELECT COUNT(*) FROM sqlite_master")
+	return err
+}
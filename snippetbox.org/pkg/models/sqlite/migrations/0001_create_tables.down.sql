@@ -0,0 +1,11 @@
+DROP TABLE IF EXISTS reports;
+DROP TABLE IF EXISTS audit_log;
+DROP TABLE IF EXISTS user_identities;
+DROP TABLE IF EXISTS webauthn_credentials;
+DROP TABLE IF EXISTS settings;
+DROP TABLE IF EXISTS recovery_codes;
+DROP TABLE IF EXISTS password_resets;
+DROP TABLE IF EXISTS snippets;
+DROP TABLE IF EXISTS users;
+DROP TABLE IF EXISTS role_permissions;
+DROP TABLE IF EXISTS roles;
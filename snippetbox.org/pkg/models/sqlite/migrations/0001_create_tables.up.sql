@@ -0,0 +1,111 @@
+CREATE TABLE roles (
+    name TEXT NOT NULL PRIMARY KEY,
+    level INTEGER NOT NULL
+);
+
+CREATE TABLE role_permissions (
+    role TEXT NOT NULL REFERENCES roles (name),
+    permission TEXT NOT NULL,
+    PRIMARY KEY (role, permission)
+);
+
+CREATE TABLE users (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL,
+    email TEXT NOT NULL UNIQUE,
+    password TEXT NOT NULL,
+    role TEXT NOT NULL DEFAULT 'author',
+    disabled BOOLEAN NOT NULL DEFAULT 0,
+    session_version INTEGER NOT NULL DEFAULT 0,
+    totp_enabled BOOLEAN NOT NULL DEFAULT 0,
+    totp_secret TEXT,
+    timezone TEXT NOT NULL DEFAULT '',
+    last_login DATETIME,
+    created DATETIME NOT NULL
+);
+
+CREATE TABLE snippets (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id INTEGER REFERENCES users (id),
+    title TEXT NOT NULL,
+    content TEXT NOT NULL,
+    content_type TEXT NOT NULL DEFAULT 'text',
+    created DATETIME NOT NULL,
+    expires DATETIME NOT NULL,
+    deleted_at DATETIME,
+    deleted_by INTEGER REFERENCES users (id),
+    hidden BOOLEAN NOT NULL DEFAULT 0
+);
+
+CREATE INDEX idx_snippets_created ON snippets (created);
+CREATE INDEX idx_snippets_user_id ON snippets (user_id);
+CREATE INDEX idx_snippets_deleted_at ON snippets (deleted_at);
+
+CREATE TABLE password_resets (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id INTEGER NOT NULL REFERENCES users (id),
+    token_hash TEXT NOT NULL UNIQUE,
+    created DATETIME NOT NULL,
+    expires DATETIME NOT NULL,
+    used DATETIME
+);
+
+CREATE TABLE recovery_codes (
+    user_id INTEGER NOT NULL REFERENCES users (id),
+    code_hash TEXT NOT NULL,
+    created DATETIME NOT NULL,
+    used DATETIME
+);
+
+CREATE INDEX idx_recovery_codes_user_id ON recovery_codes (user_id);
+
+CREATE TABLE settings (
+    name TEXT NOT NULL PRIMARY KEY,
+    value TEXT NOT NULL
+);
+
+CREATE TABLE webauthn_credentials (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id INTEGER NOT NULL REFERENCES users (id),
+    credential_id BLOB NOT NULL UNIQUE,
+    name TEXT NOT NULL,
+    credential BLOB NOT NULL,
+    created DATETIME NOT NULL,
+    last_used DATETIME
+);
+
+CREATE INDEX idx_webauthn_credentials_user_id ON webauthn_credentials (user_id);
+
+CREATE TABLE user_identities (
+    user_id INTEGER NOT NULL REFERENCES users (id),
+    issuer TEXT NOT NULL,
+    subject TEXT NOT NULL,
+    created DATETIME NOT NULL,
+    PRIMARY KEY (issuer, subject)
+);
+
+CREATE TABLE audit_log (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    action TEXT NOT NULL,
+    actor_id INTEGER,
+    target TEXT NOT NULL,
+    ip TEXT NOT NULL,
+    user_agent TEXT NOT NULL,
+    created DATETIME NOT NULL
+);
+
+CREATE INDEX idx_audit_log_created ON audit_log (created);
+
+CREATE TABLE reports (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    snippet_id INTEGER NOT NULL REFERENCES snippets (id) ON DELETE CASCADE,
+    reporter_id INTEGER NOT NULL REFERENCES users (id),
+    reason TEXT NOT NULL,
+    status TEXT NOT NULL,
+    moderator_id INTEGER REFERENCES users (id),
+    created DATETIME NOT NULL,
+    resolved_at DATETIME,
+    UNIQUE (snippet_id, reporter_id)
+);
+
+CREATE INDEX idx_reports_status ON reports (status);
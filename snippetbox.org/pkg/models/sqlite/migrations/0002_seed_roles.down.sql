@@ -0,0 +1,2 @@
+DELETE FROM role_permissions WHERE role IN ('viewer', 'author', 'moderator', 'admin');
+DELETE FROM roles WHERE name IN ('viewer', 'author', 'moderator', 'admin');
@@ -0,0 +1,21 @@
+INSERT INTO roles (name, level) VALUES
+    ('viewer', 1),
+    ('author', 2),
+    ('moderator', 3),
+    ('admin', 4);
+
+INSERT INTO role_permissions (role, permission) VALUES
+    ('viewer', 'snippets:view'),
+    ('author', 'snippets:view'),
+    ('author', 'snippets:create'),
+    ('moderator', 'snippets:view'),
+    ('moderator', 'snippets:create'),
+    ('moderator', 'snippets:delete'),
+    ('moderator', 'snippets:moderate'),
+    ('admin', 'snippets:view'),
+    ('admin', 'snippets:create'),
+    ('admin', 'snippets:delete'),
+    ('admin', 'snippets:moderate'),
+    ('admin', 'users:manage'),
+    ('admin', 'settings:manage'),
+    ('admin', 'audit:view');
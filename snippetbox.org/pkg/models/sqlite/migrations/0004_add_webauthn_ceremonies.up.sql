@@ -0,0 +1,11 @@
+-- WebAuthn challenges waiting for an answer. They're kept here rather than in
+-- the session cookie, which a browser could send again, so that each one can
+-- only be answered once.
+
+CREATE TABLE webauthn_ceremonies (
+    challenge TEXT NOT NULL PRIMARY KEY,
+    data BLOB NOT NULL,
+    expires DATETIME NOT NULL
+);
+
+CREATE INDEX idx_webauthn_ceremonies_expires ON webauthn_ceremonies (expires);
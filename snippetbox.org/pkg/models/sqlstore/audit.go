@@ -0,0 +1,90 @@
+package sqlstore
+
+import (
+	"context"
+	"strings"
+
+	"snippetbox.org/pkg/models"
+)
+
+// InsertAudit appends an entry to the audit log. The log is append-only: there
+// are deliberately no methods to change or remove entries.
+func (db *Store) InsertAudit(ctx context.Context, e *models.AuditEntry) error {
+	stmt := `INSERT INTO audit_log (action, actor_id, target, ip, user_agent, created)
+VALUES(?, NULLIF(?, 0), ?, ?, ?, ?)`
+
+	_, err := db.ExecContext(ctx, stmt, e.Action, e.ActorID, e.Target, e.IP, e.UserAgent, now())
+	return err
+}
+
+// AuditEntries returns the entries matching the filter, newest first, along with
+// the total number of matches. A limit of 0 returns every match.
+func (db *Store) AuditEntries(ctx context.Context, f *models.AuditFilter, offset, limit int) ([]*models.AuditEntry, int, error) {
+	where, args := db.auditWhere(f)
+
+	var total int
+	stmt := `SELECT COUNT(*) FROM audit_log a LEFT JOIN users u ON u.id = a.actor_id WHERE ` + where
+	err := db.QueryRowContext(ctx, stmt, args...).Scan(&total)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	stmt = `SELECT a.id, a.action, COALESCE(a.actor_id, 0), COALESCE(u.name, ''), a.target, a.ip, a.user_agent,
+a.created FROM audit_log a LEFT JOIN users u ON u.id = a.actor_id WHERE ` + where + ` ORDER BY a.id DESC`
+	if limit > 0 {
+		stmt += ` LIMIT ? OFFSET ?`
+		args = append(args, limit, offset)
+	}
+
+	rows, err := db.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	entries := []*models.AuditEntry{}
+	for rows.Next() {
+		e := &models.AuditEntry{}
+		err := rows.Scan(&e.ID, &e.Action, &e.ActorID, &e.ActorName, &e.Target, &e.IP, &e.UserAgent, &e.Created)
+		if err != nil {
+			return nil, 0, err
+		}
+		entries = append(entries, e)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	return entries, total, nil
+}
+
+// auditWhere returns the SQL condition and arguments for the filter. It
+// expects the audit_log table aliased as a, joined to users aliased as u.
+func (db *Store) auditWhere(f *models.AuditFilter) (string, []interface{}) {
+	clauses := []string{"1 = 1"}
+	args := []interface{}{}
+
+	if f.Action != "" {
+		clauses = append(clauses, "a.action = ?")
+		args = append(args, f.Action)
+	}
+	if f.Actor != "" {
+		clauses = append(clauses, "("+db.like("u.name")+" OR "+db.like("u.email")+")")
+		args = append(args, contains(f.Actor), contains(f.Actor))
+	}
+	if f.Target != "" {
+		clauses = append(clauses, db.like("a.target"))
+		args = append(args, contains(f.Target))
+	}
+	if !f.From.IsZero() {
+		clauses = append(clauses, "a.created >= ?")
+		args = append(args, f.From.UTC())
+	}
+	if !f.To.IsZero() {
+		clauses = append(clauses, "a.created < ?")
+		args = append(args, f.To.UTC())
+	}
+
+	return strings.Join(clauses, " AND "), args
+}
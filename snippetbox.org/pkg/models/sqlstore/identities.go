@@ -0,0 +1,72 @@
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+
+	"snippetbox.org/pkg/models"
+)
+
+// GetUserByIdentity returns the user linked to the given subject at an external
+// identity provider, or nil if nobody is linked to it yet.
+func (db *Store) GetUserByIdentity(ctx context.Context, issuer, subject string) (*models.User, error) {
+	var id int
+	stmt := `SELECT user_id FROM user_identities WHERE issuer = ? AND subject = ?`
+	err := db.QueryRowContext(ctx, stmt, issuer, subject).Scan(&id)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return db.GetUser(ctx, id)
+}
+
+// LinkIdentity records that the subject at an identity provider is the given
+// user, so that later logins from the provider find the same account.
+func (db *Store) LinkIdentity(ctx context.Context, userID int, issuer, subject string) error {
+	stmt := `INSERT INTO user_identities (user_id, issuer, subject, created) VALUES(?, ?, ?, ?)`
+
+	_, err := db.ExecContext(ctx, stmt, userID, issuer, subject, now())
+	return err
+}
+
+// InsertIdentityUser creates a user for someone logging in through an identity
+// provider for the first time, and links them to it. They get a random password
+// that nobody knows; they can set a real one with a password reset.
+func (db *Store) InsertIdentityUser(ctx context.Context, name, email, issuer, subject string) (int, error) {
+	hashedPassword, err := models.UnusablePassword()
+	if err != nil {
+		return 0, err
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	stmt := `INSERT INTO users (name, email, password, role, created)
+VALUES(?, ?, ?, ?, ?)`
+
+	result, err := tx.ExecContext(ctx, stmt, name, email, string(hashedPassword), models.DefaultRole, now())
+	if err != nil {
+		if db.dialect.IsDuplicate(err) {
+			return 0, models.ErrDuplicateEmail
+		}
+		return 0, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+
+	stmt = `INSERT INTO user_identities (user_id, issuer, subject, created) VALUES(?, ?, ?, ?)`
+	_, err = tx.ExecContext(ctx, stmt, id, issuer, subject, now())
+	if err != nil {
+		return 0, err
+	}
+
+	return int(id), tx.Commit()
+}
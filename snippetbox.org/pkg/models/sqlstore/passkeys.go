@@ -0,0 +1,138 @@
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/go-webauthn/webauthn/webauthn"
+
+	"snippetbox.org/pkg/models"
+)
+
+// InsertPasskey stores a newly registered WebAuthn credential for a user. The
+// credential is kept as JSON, with its ID in a separate indexed column so that
+// logins can look it up.
+func (db *Store) InsertPasskey(ctx context.Context, userID int, name string, cred *webauthn.Credential) error {
+	j, err := json.Marshal(cred)
+	if err != nil {
+		return err
+	}
+
+	stmt := `INSERT INTO webauthn_credentials (user_id, credential_id, name, credential, created)
+VALUES(?, ?, ?, ?, ?)`
+
+	_, err = db.ExecContext(ctx, stmt, userID, cred.ID, name, j, now())
+	return err
+}
+
+func (db *Store) UserPasskeys(ctx context.Context, userID int) ([]*models.Passkey, error) {
+	stmt := `SELECT id, user_id, name, credential, created, last_used FROM webauthn_credentials
+WHERE user_id = ? ORDER BY created`
+
+	rows, err := db.QueryContext(ctx, stmt, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	passkeys := []*models.Passkey{}
+	for rows.Next() {
+		p := &models.Passkey{}
+		var j []byte
+		var lastUsed sql.NullTime
+
+		err := rows.Scan(&p.ID, &p.UserID, &p.Name, &j, &p.Created, &lastUsed)
+		if err != nil {
+			return nil, err
+		}
+
+		err = json.Unmarshal(j, &p.Credential)
+		if err != nil {
+			return nil, err
+		}
+
+		if lastUsed.Valid {
+			p.LastUsed = &lastUsed.Time
+		}
+
+		passkeys = append(passkeys, p)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return passkeys, nil
+}
+
+// UpdatePasskey saves the credential after a successful login, which records
+// the authenticator's new signature counter, and sets its last used time.
+func (db *Store) UpdatePasskey(ctx context.Context, cred *webauthn.Credential) error {
+	j, err := json.Marshal(cred)
+	if err != nil {
+		return err
+	}
+
+	stmt := `UPDATE webauthn_credentials SET credential = ?, last_used = ? WHERE credential_id = ?`
+
+	_, err = db.ExecContext(ctx, stmt, j, now(), cred.ID)
+	return err
+}
+
+func (db *Store) DeletePasskey(ctx context.Context, userID, id int) error {
+	_, err := db.ExecContext(ctx, "DELETE FROM webauthn_credentials WHERE id = ? AND user_id = ?", id, userID)
+	return err
+}
+
+// InsertCeremony keeps the WebAuthn session data for a registration or login
+// until ttl has passed or TakeCeremony has been called for its challenge.
+// Ceremonies that were never finished are cleared out as new ones start.
+func (db *Store) InsertCeremony(ctx context.Context, data *webauthn.SessionData, ttl time.Duration) error {
+	j, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	_, err = db.ExecContext(ctx, "DELETE FROM webauthn_ceremonies WHERE expires <= ?", now())
+	if err != nil {
+		return err
+	}
+
+	stmt := `INSERT INTO webauthn_ceremonies (challenge, data, expires) VALUES(?, ?, ?)`
+	_, err = db.ExecContext(ctx, stmt, data.Challenge, j, now().Add(ttl))
+	return err
+}
+
+// TakeCeremony removes and returns the session data for the challenge. It
+// returns nil if there's none, or it has expired, or it was already taken.
+func (db *Store) TakeCeremony(ctx context.Context, challenge string) (*webauthn.SessionData, error) {
+	var j []byte
+	stmt := `SELECT data FROM webauthn_ceremonies WHERE challenge = ? AND expires > ?`
+	err := db.QueryRowContext(ctx, stmt, challenge, now()).Scan(&j)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	// Only whoever deletes the row gets the data, so two requests answering the
+	// same challenge at once can't both succeed.
+	result, err := db.ExecContext(ctx, "DELETE FROM webauthn_ceremonies WHERE challenge = ?", challenge)
+	if err != nil {
+		return nil, err
+	}
+	n, err := result.RowsAffected()
+	if err != nil || n != 1 {
+		return nil, err
+	}
+
+	data := &webauthn.SessionData{}
+	err = json.Unmarshal(j, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
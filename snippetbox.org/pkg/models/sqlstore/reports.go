@@ -0,0 +1,101 @@
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+
+	"snippetbox.org/pkg/models"
+)
+
+// InsertReport files a report against a snippet. Reporting the same snippet
+// twice is a no-op.
+func (db *Store) InsertReport(ctx context.Context, snippetID, reporterID int, reason string) error {
+	stmt := `INSERT INTO reports (snippet_id, reporter_id, reason, status, created) VALUES(?, ?, ?, ?, ?)`
+
+	_, err := db.ExecContext(ctx, stmt, snippetID, reporterID, reason, models.ReportOpen, now())
+	if db.dialect.IsDuplicate(err) {
+		return nil
+	}
+	return err
+}
+
+const reportColumns = `r.id, r.snippet_id, s.title, s.content, COALESCE(s.user_id, 0), COALESCE(a.name, ''),
+COALESCE(rep.name, ''), r.reason, r.status, COALESCE(m.name, ''), r.created, r.resolved_at`
+
+const reportTables = `reports r JOIN snippets s ON s.id = r.snippet_id LEFT JOIN users a ON a.id = s.user_id
+LEFT JOIN users rep ON rep.id = r.reporter_id LEFT JOIN users m ON m.id = r.moderator_id`
+
+func scanReport(row interface{ Scan(...interface{}) error }) (*models.Report, error) {
+	r := &models.Report{}
+	err := row.Scan(&r.ID, &r.SnippetID, &r.SnippetTitle, &r.SnippetContent, &r.AuthorID, &r.AuthorName,
+		&r.ReporterName, &r.Reason, &r.Status, &r.ModeratorName, &r.Created, &r.Resolved)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (db *Store) GetReport(ctx context.Context, id int) (*models.Report, error) {
+	row := db.QueryRowContext(ctx, `SELECT `+reportColumns+` FROM `+reportTables+` WHERE r.id = ?`, id)
+
+	r, err := scanReport(row)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// OpenReports returns the moderation queue, oldest first.
+func (db *Store) OpenReports(ctx context.Context) ([]*models.Report, error) {
+	return db.reports(ctx, `SELECT `+reportColumns+` FROM `+reportTables+` WHERE r.status = ? ORDER BY r.created`,
+		models.ReportOpen)
+}
+
+// ResolvedReports returns the most recent moderation decisions.
+func (db *Store) ResolvedReports(ctx context.Context, limit int) ([]*models.Report, error) {
+	return db.reports(ctx, `SELECT `+reportColumns+` FROM `+reportTables+` WHERE r.status <> ?
+ORDER BY r.resolved_at DESC LIMIT ?`, models.ReportOpen, limit)
+}
+
+func (db *Store) reports(ctx context.Context, stmt string, args ...interface{}) ([]*models.Report, error) {
+	rows, err := db.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	reports := []*models.Report{}
+	for rows.Next() {
+		r, err := scanReport(rows)
+		if err != nil {
+			return nil, err
+		}
+		reports = append(reports, r)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return reports, nil
+}
+
+// ResolveReports closes every open report against a snippet with the
+// moderator's decision, so duplicate reports leave the queue together.
+func (db *Store) ResolveReports(ctx context.Context, snippetID, moderatorID int, status string) error {
+	stmt := `UPDATE reports SET status = ?, moderator_id = ?, resolved_at = ?
+WHERE snippet_id = ? AND status = ?`
+
+	_, err := db.ExecContext(ctx, stmt, status, moderatorID, now(), snippetID, models.ReportOpen)
+	return err
+}
+
+// SetHidden hides a snippet from everyone, or shows it again. Unlike deleting,
+// hiding never expires.
+func (db *Store) SetHidden(ctx context.Context, snippetID int, hidden bool) error {
+	_, err := db.ExecContext(ctx, "UPDATE snippets SET hidden = ? WHERE id = ?", hidden, snippetID)
+	return err
+}
@@ -0,0 +1,104 @@
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"snippetbox.org/pkg/models"
+)
+
+// InsertPasswordReset creates a password reset token for the user with the given
+// email, valid for ttl. The plain-text token is returned so that it can be sent
+// to the user. If no user has that email a nil user is returned.
+func (db *Store) InsertPasswordReset(ctx context.Context, email string, ttl time.Duration) (*models.User, string, error) {
+	u := &models.User{}
+	row := db.QueryRowContext(ctx, "SELECT id, name, email FROM users WHERE email = ?", email)
+	err := row.Scan(&u.ID, &u.Name, &u.Email)
+	if err == sql.ErrNoRows {
+		return nil, "", nil
+	} else if err != nil {
+		return nil, "", err
+	}
+
+	token, hash, err := models.NewResetToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	stmt := `INSERT INTO password_resets (user_id, token_hash, created, expires)
+VALUES(?, ?, ?, ?)`
+
+	created := now()
+	_, err = db.ExecContext(ctx, stmt, u.ID, hash, created, created.Add(ttl.Truncate(time.Second)))
+	if err != nil {
+		return nil, "", err
+	}
+
+	return u, token, nil
+}
+
+// PasswordResetValid reports whether the token exists, hasn't been used and
+// hasn't expired.
+func (db *Store) PasswordResetValid(ctx context.Context, token string) (bool, error) {
+	stmt := `SELECT id FROM password_resets WHERE token_hash = ? AND used IS NULL AND expires > ?`
+
+	var id int
+	err := db.QueryRowContext(ctx, stmt, models.HashResetToken(token), now()).Scan(&id)
+	if err == sql.ErrNoRows {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// ResetPassword sets a new password for the user that owns the token and
+// returns their ID. The token, along with any other outstanding tokens for the
+// same user, is marked as used and the user's session version is bumped so that
+// every existing session is logged out. ErrInvalidResetToken is returned if the
+// token is unknown, used or expired.
+func (db *Store) ResetPassword(ctx context.Context, token, password string) (int, error) {
+	hashedPassword, err := models.HashPassword(password)
+	if err != nil {
+		return 0, err
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	// Locking the token's row stops it being used twice at the same time. A
+	// database that can't lock rows must only allow one writer at a time.
+	stmt := `SELECT user_id FROM password_resets WHERE token_hash = ? AND used IS NULL AND expires > ?` + db.dialect.ForUpdate
+
+	var userID int
+	err = tx.QueryRowContext(ctx, stmt, models.HashResetToken(token), now()).Scan(&userID)
+	if err == sql.ErrNoRows {
+		return 0, models.ErrInvalidResetToken
+	} else if err != nil {
+		return 0, err
+	}
+
+	stmt = `UPDATE users SET password = ?, session_version = session_version + 1 WHERE id = ?`
+	_, err = tx.ExecContext(ctx, stmt, string(hashedPassword), userID)
+	if err != nil {
+		return 0, err
+	}
+
+	stmt = `UPDATE password_resets SET used = ? WHERE user_id = ? AND used IS NULL`
+	_, err = tx.ExecContext(ctx, stmt, now(), userID)
+	if err != nil {
+		return 0, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return 0, err
+	}
+
+	return userID, nil
+}
@@ -0,0 +1,73 @@
+package sqlstore
+
+import (
+	"context"
+
+	"snippetbox.org/pkg/models"
+)
+
+// RolePermissions returns the set of permissions granted to a role.
+func (db *Store) RolePermissions(ctx context.Context, role string) (map[string]bool, error) {
+	rows, err := db.QueryContext(ctx, "SELECT permission FROM role_permissions WHERE role = ?", role)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	perms := make(map[string]bool)
+	for rows.Next() {
+		var perm string
+		err := rows.Scan(&perm)
+		if err != nil {
+			return nil, err
+		}
+		perms[perm] = true
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return perms, nil
+}
+
+// Roles returns the names of all roles, from least to most privileged.
+func (db *Store) Roles(ctx context.Context) ([]string, error) {
+	rows, err := db.QueryContext(ctx, "SELECT name FROM roles ORDER BY level")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	roles := []string{}
+	for rows.Next() {
+		var role string
+		err := rows.Scan(&role)
+		if err != nil {
+			return nil, err
+		}
+		roles = append(roles, role)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return roles, nil
+}
+
+// SetRole changes a user's role. ErrUnknownRole is returned if the role doesn't
+// exist.
+func (db *Store) SetRole(ctx context.Context, userID int, role string) error {
+	var n int
+	err := db.QueryRowContext(ctx, "SELECT COUNT(*) FROM roles WHERE name = ?", role).Scan(&n)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return models.ErrUnknownRole
+	}
+
+	_, err = db.ExecContext(ctx, "UPDATE users SET role = ? WHERE id = ?", role, userID)
+	return err
+}
@@ -0,0 +1,254 @@
+// Package sqlstore keeps snippets and users in a SQL database. The queries are
+// shared by every database the application supports, and the mysql and sqlite
+// packages fill in a Dialect for the few places where they differ.
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"strings"
+	"time"
+
+	"snippetbox.org/pkg/models"
+)
+
+// Dialect is what the queries need to know about the database they run on.
+type Dialect struct {
+	// IsDuplicate reports whether err is a unique key failing.
+	IsDuplicate func(err error) bool
+
+	// Upsert returns the clause that makes an INSERT set column to the new
+	// value instead when a row with the same key already exists.
+	Upsert func(key, column string) string
+
+	// ForUpdate is added to a SELECT in a transaction to lock the rows it
+	// reads, if the database can.
+	ForUpdate string
+
+	// Backslash is a string literal holding one backslash, which the two
+	// databases quote differently.
+	Backslash string
+}
+
+type Store struct {
+	*sql.DB
+	dialect Dialect
+}
+
+var (
+	_ models.SnippetStore = (*Store)(nil)
+	_ models.UserStore    = (*Store)(nil)
+)
+
+func New(db *sql.DB, dialect Dialect) *Store {
+	return &Store{DB: db, dialect: dialect}
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// contains returns a pattern for like that matches s anywhere, with any
+// wildcards in s matched literally.
+func contains(s string) string {
+	return "%" + likeEscaper.Replace(s) + "%"
+}
+
+// like returns a LIKE condition on column for a pattern made by contains.
+func (db *Store) like(column string) string {
+	return column + " LIKE ? ESCAPE " + db.dialect.Backslash
+}
+
+// now returns the current time in UTC to the second. Times are passed to the
+// database rather than read from its clock so that every database stores them
+// the same way; SQLite keeps them as text, so they must all be written in the
+// same zone and precision for comparisons between them to work.
+func now() time.Time {
+	return time.Now().UTC().Truncate(time.Second)
+}
+
+func (db *Store) GetSnippet(ctx context.Context, id int) (*models.Snippet, error) {
+	stmt := `SELECT id, COALESCE(user_id, 0), title, content, content_type, created, expires FROM snippets WHERE expires > ? AND deleted_at IS NULL AND NOT hidden AND id = ?`
+
+	row := db.QueryRowContext(ctx, stmt, now(), id)
+
+	s := &models.Snippet{}
+
+	err := row.Scan(&s.ID, &s.UserID, &s.Title, &s.Content, &s.ContentType, &s.Created, &s.Expires)
+
+	if err == sql.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+
+}
+
+func (db *Store) LatestSnippets(ctx context.Context) (models.Snippets, error) {
+	stmt := `SELECT id, COALESCE(user_id, 0), title, content, created, expires FROM snippets WHERE expires > ? AND deleted_at IS NULL AND NOT hidden ORDER BY created DESC LIMIT 10`
+
+	rows, err := db.QueryContext(ctx, stmt, now())
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	snippets := models.Snippets{}
+
+	for rows.Next() {
+		s := &models.Snippet{}
+
+		err := rows.Scan(&s.ID, &s.UserID, &s.Title, &s.Content, &s.Created, &s.Expires)
+
+		if err != nil {
+			return nil, err
+		}
+
+		snippets = append(snippets, s)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return snippets, nil
+}
+
+func (db *Store) InsertSnippet(ctx context.Context, userID int, title, content, contentType, expires string) (int, error) {
+	seconds, err := strconv.Atoi(expires)
+	if err != nil {
+		return 0, err
+	}
+
+	stmt := `INSERT INTO snippets (user_id, title, content, content_type, created, expires) VALUES(?, ?, ?, ?, ?, ?)`
+
+	created := now()
+	result, err := db.ExecContext(ctx, stmt, userID, title, content, contentType, created, created.Add(time.Duration(seconds)*time.Second))
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := result.LastInsertId()
+
+	if err != nil {
+		return 0, err
+	}
+
+	return int(id), nil
+}
+
+func (db *Store) InsertUser(ctx context.Context, name, email, password string) error {
+	return db.insertUser(ctx, name, email, password, models.DefaultRole)
+}
+
+func (db *Store) VerifyUser(ctx context.Context, email, password string) (int, error) {
+	// Retrieve the id and hashed password associated with the given email. If no
+	// matching email exists, we return the ErrInvalidCredentials error.
+	var id int
+	var hashedPassword []byte
+	row := db.QueryRowContext(ctx, "SELECT id, password FROM users WHERE email = ?", email)
+	err := row.Scan(&id, &hashedPassword)
+	if err == sql.ErrNoRows {
+		return 0, models.ErrInvalidCredentials
+	} else if err != nil {
+		return 0, err
+	}
+
+	// Check whether the hashed password and plain-text password provided match.
+	// If they don't, we return the ErrInvalidCredentials error.
+	err = models.CheckPassword(hashedPassword, password)
+	if err != nil {
+		return 0, err
+	}
+
+	// Otherwise, the password is correct. Return the user ID.
+	return id, nil
+}
+
+func (db *Store) InsertAdmin(ctx context.Context, name, email, password string) error {
+	return db.insertUser(ctx, name, email, password, models.RoleAdmin)
+}
+
+func (db *Store) insertUser(ctx context.Context, name, email, password, role string) error {
+	hashedPassword, err := models.HashPassword(password)
+	if err != nil {
+		return err
+	}
+
+	stmt := `INSERT INTO users (name, email, password, role, created)
+VALUES(?, ?, ?, ?, ?)`
+
+	_, err = db.ExecContext(ctx, stmt, name, email, string(hashedPassword), role, now())
+	if err != nil {
+		if db.dialect.IsDuplicate(err) {
+			return models.ErrDuplicateEmail
+		}
+	}
+
+	return err
+}
+
+// DeleteSnippet moves a snippet to the trash, recording who deleted it. Trashed
+// snippets are hidden everywhere else until they're restored or purged.
+func (db *Store) DeleteSnippet(ctx context.Context, id, deletedBy int) error {
+	stmt := `UPDATE snippets SET deleted_at = ?, deleted_by = NULLIF(?, 0) WHERE id = ? AND deleted_at IS NULL`
+
+	_, err := db.ExecContext(ctx, stmt, now(), deletedBy, id)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *Store) GetUser(ctx context.Context, id int) (*models.User, error) {
+	return db.getUser(ctx, "id = ?", id)
+}
+
+func (db *Store) GetUserByEmail(ctx context.Context, email string) (*models.User, error) {
+	return db.getUser(ctx, "email = ?", email)
+}
+
+// getUser returns the user matching the WHERE clause, or nil if there isn't one.
+func (db *Store) getUser(ctx context.Context, where string, args ...interface{}) (*models.User, error) {
+	stmt := `SELECT ` + userColumns + ` FROM users WHERE ` + where
+
+	u, err := scanUser(db.QueryRowContext(ctx, stmt, args...))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	u.Permissions, err = db.RolePermissions(ctx, u.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	return u, nil
+}
+
+const userColumns = `id, name, email, role, disabled, session_version, totp_enabled, COALESCE(totp_secret, ''),
+timezone, last_login, created`
+
+// scanUser scans a row selected with userColumns, from either *sql.Row or
+// *sql.Rows. It doesn't load the user's permissions.
+func scanUser(row interface{ Scan(...interface{}) error }) (*models.User, error) {
+	u := &models.User{}
+	var lastLogin sql.NullTime
+
+	err := row.Scan(&u.ID, &u.Name, &u.Email, &u.Role, &u.Disabled, &u.SessionVersion, &u.TOTPEnabled,
+		&u.TOTPSecret, &u.Timezone, &lastLogin, &u.Created)
+	if err != nil {
+		return nil, err
+	}
+
+	if lastLogin.Valid {
+		u.LastLogin = &lastLogin.Time
+	}
+
+	return u, nil
+}
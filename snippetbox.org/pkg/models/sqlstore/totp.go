@@ -0,0 +1,157 @@
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+
+	"snippetbox.org/pkg/models"
+)
+
+// EnableTOTP stores the TOTP secret for a user, turns on two-factor
+// authentication, and replaces any existing recovery codes with a fresh set.
+// The plain-text recovery codes are returned so they can be shown to the user
+// once; only their hashes are stored.
+func (db *Store) EnableTOTP(ctx context.Context, userID int, secret string) ([]string, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, "UPDATE users SET totp_secret = ?, totp_enabled = 1, totp_last_step = NULL WHERE id = ?", secret, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	codes, err := replaceRecoveryCodes(ctx, tx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return codes, tx.Commit()
+}
+
+// RegenerateRecoveryCodes throws away a user's remaining recovery codes and
+// returns a new set.
+func (db *Store) RegenerateRecoveryCodes(ctx context.Context, userID int) ([]string, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	codes, err := replaceRecoveryCodes(ctx, tx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return codes, tx.Commit()
+}
+
+func replaceRecoveryCodes(ctx context.Context, tx *sql.Tx, userID int) ([]string, error) {
+	_, err := tx.ExecContext(ctx, "DELETE FROM recovery_codes WHERE user_id = ?", userID)
+	if err != nil {
+		return nil, err
+	}
+
+	codes := make([]string, models.RecoveryCodeCount)
+	for i := range codes {
+		codes[i], err = models.NewRecoveryCode()
+		if err != nil {
+			return nil, err
+		}
+
+		stmt := `INSERT INTO recovery_codes (user_id, code_hash, created) VALUES(?, ?, ?)`
+		_, err = tx.ExecContext(ctx, stmt, userID, models.HashRecoveryCode(codes[i]), now())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return codes, nil
+}
+
+// DisableTOTP turns off two-factor authentication for a user and removes their
+// secret and recovery codes.
+func (db *Store) DisableTOTP(ctx context.Context, userID int) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, "UPDATE users SET totp_secret = NULL, totp_enabled = 0, totp_last_step = NULL WHERE id = ?", userID)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM recovery_codes WHERE user_id = ?", userID)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// UseRecoveryCode checks a recovery code for the user and, if it's valid and
+// hasn't been used before, marks it as used and returns true.
+func (db *Store) UseRecoveryCode(ctx context.Context, userID int, code string) (bool, error) {
+	stmt := `UPDATE recovery_codes SET used = ? WHERE user_id = ? AND code_hash = ? AND used IS NULL`
+
+	result, err := db.ExecContext(ctx, stmt, now(), userID, models.HashRecoveryCode(code))
+	if err != nil {
+		return false, err
+	}
+
+	n, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return n == 1, nil
+}
+
+// UseTOTPStep records that the user has used the TOTP code for the time step
+// and returns true, unless they've already used the code for that step or a
+// later one, so that each code only works once.
+func (db *Store) UseTOTPStep(ctx context.Context, userID int, step int64) (bool, error) {
+	stmt := `UPDATE users SET totp_last_step = ? WHERE id = ? AND (totp_last_step IS NULL OR totp_last_step < ?)`
+
+	result, err := db.ExecContext(ctx, stmt, step, userID, step)
+	if err != nil {
+		return false, err
+	}
+
+	n, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return n == 1, nil
+}
+
+// RequireAdmin2FA reports whether admins must have two-factor authentication
+// enabled before they're given admin rights.
+func (db *Store) RequireAdmin2FA(ctx context.Context) (bool, error) {
+	var value string
+	err := db.QueryRowContext(ctx, "SELECT value FROM settings WHERE name = 'require_admin_2fa'").Scan(&value)
+	if err == sql.ErrNoRows {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return value == "1", nil
+}
+
+func (db *Store) SetRequireAdmin2FA(ctx context.Context, required bool) error {
+	value := "0"
+	if required {
+		value = "1"
+	}
+
+	stmt := `INSERT INTO settings (name, value) VALUES('require_admin_2fa', ?) ` + db.dialect.Upsert("name", "value")
+
+	_, err := db.ExecContext(ctx, stmt, value)
+	return err
+}
@@ -0,0 +1,85 @@
+package sqlstore
+
+import (
+	"context"
+	"time"
+
+	"snippetbox.org/pkg/models"
+)
+
+// trashCutoff returns the time before which trashed snippets are past the
+// retention window.
+func trashCutoff(retentionDays int) time.Time {
+	return now().AddDate(0, 0, -retentionDays)
+}
+
+// TrashedSnippets returns the snippets in the trash that can still be restored,
+// most recently deleted first. An ownerID of 0 returns everyone's trash;
+// otherwise only the snippets that user owns and deleted themselves are
+// returned. Snippets a moderator deleted stay out of their owner's reach.
+func (db *Store) TrashedSnippets(ctx context.Context, ownerID, retentionDays int) (models.Snippets, error) {
+	stmt := `SELECT id, COALESCE(user_id, 0), title, content, created, expires, deleted_at, COALESCE(deleted_by, 0)
+FROM snippets WHERE deleted_at > ? AND (? = 0 OR (user_id = ? AND (deleted_by IS NULL OR deleted_by = user_id)))
+ORDER BY deleted_at DESC`
+
+	rows, err := db.QueryContext(ctx, stmt, trashCutoff(retentionDays), ownerID, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	snippets := models.Snippets{}
+	for rows.Next() {
+		s := &models.Snippet{}
+		err := rows.Scan(&s.ID, &s.UserID, &s.Title, &s.Content, &s.Created, &s.Expires, &s.Deleted, &s.DeletedBy)
+		if err != nil {
+			return nil, err
+		}
+		snippets = append(snippets, s)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return snippets, nil
+}
+
+// RestoreSnippet takes a snippet back out of the trash, as long as it's still
+// within the retention window. An ownerID of 0 restores anyone's snippet;
+// otherwise only a snippet that user owns and deleted themselves is restored.
+// It reports whether a snippet was restored.
+func (db *Store) RestoreSnippet(ctx context.Context, id, ownerID, retentionDays int) (bool, error) {
+	stmt := `UPDATE snippets SET deleted_at = NULL, deleted_by = NULL
+WHERE id = ? AND deleted_at > ? AND (? = 0 OR (user_id = ? AND (deleted_by IS NULL OR deleted_by = user_id)))`
+
+	result, err := db.ExecContext(ctx, stmt, id, trashCutoff(retentionDays), ownerID, ownerID)
+	if err != nil {
+		return false, err
+	}
+
+	n, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return n > 0, nil
+}
+
+// PurgeSnippets permanently deletes snippets that have been in the trash for
+// longer than the retention window, and returns how many were removed.
+func (db *Store) PurgeSnippets(ctx context.Context, retentionDays int) (int, error) {
+	stmt := `DELETE FROM snippets WHERE deleted_at <= ?`
+
+	result, err := db.ExecContext(ctx, stmt, trashCutoff(retentionDays))
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(n), nil
+}
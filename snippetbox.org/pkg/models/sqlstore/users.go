@@ -0,0 +1,117 @@
+package sqlstore
+
+import (
+	"context"
+
+	"snippetbox.org/pkg/models"
+)
+
+// SearchUsers returns a page of users whose name or email contains query,
+// ordered by name, along with the total number of matching users.
+func (db *Store) SearchUsers(ctx context.Context, query string, offset, limit int) ([]*models.User, int, error) {
+	pattern := contains(query)
+	where := db.like("name") + " OR " + db.like("email")
+
+	var total int
+	stmt := `SELECT COUNT(*) FROM users WHERE ` + where
+	err := db.QueryRowContext(ctx, stmt, pattern, pattern).Scan(&total)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	stmt = `SELECT ` + userColumns + ` FROM users WHERE ` + where + ` ORDER BY name, id LIMIT ? OFFSET ?`
+	rows, err := db.QueryContext(ctx, stmt, pattern, pattern, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	users := []*models.User{}
+	for rows.Next() {
+		u, err := scanUser(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		users = append(users, u)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	return users, total, nil
+}
+
+// UserSnippets returns every snippet created by the user, including expired
+// ones but not trashed ones, newest first.
+func (db *Store) UserSnippets(ctx context.Context, userID int) (models.Snippets, error) {
+	stmt := `SELECT id, user_id, title, content, created, expires FROM snippets WHERE user_id = ? AND deleted_at IS NULL ORDER BY created DESC`
+
+	rows, err := db.QueryContext(ctx, stmt, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	snippets := models.Snippets{}
+	for rows.Next() {
+		s := &models.Snippet{}
+		err := rows.Scan(&s.ID, &s.UserID, &s.Title, &s.Content, &s.Created, &s.Expires)
+		if err != nil {
+			return nil, err
+		}
+		snippets = append(snippets, s)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return snippets, nil
+}
+
+// RecordLogin sets the user's last login time to now.
+func (db *Store) RecordLogin(ctx context.Context, userID int) error {
+	_, err := db.ExecContext(ctx, "UPDATE users SET last_login = ? WHERE id = ?", now(), userID)
+	return err
+}
+
+// SetTimezone sets the zone the user wants dates shown in. An empty zone goes
+// back to the browser's.
+func (db *Store) SetTimezone(ctx context.Context, userID int, timezone string) error {
+	_, err := db.ExecContext(ctx, "UPDATE users SET timezone = ? WHERE id = ?", timezone, userID)
+	return err
+}
+
+// SetDisabled disables or re-enables a user. Disabling also logs them out
+// everywhere.
+func (db *Store) SetDisabled(ctx context.Context, userID int, disabled bool) error {
+	stmt := `UPDATE users SET disabled = ?, session_version = session_version + 1 WHERE id = ?`
+	if !disabled {
+		stmt = `UPDATE users SET disabled = ? WHERE id = ?`
+	}
+
+	_, err := db.ExecContext(ctx, stmt, disabled, userID)
+	return err
+}
+
+// LogoutEverywhere bumps the user's session version, which ends all of their
+// existing sessions.
+func (db *Store) LogoutEverywhere(ctx context.Context, userID int) error {
+	_, err := db.ExecContext(ctx, "UPDATE users SET session_version = session_version + 1 WHERE id = ?", userID)
+	return err
+}
+
+// ClearPassword replaces the user's password with a random one that nobody
+// knows and logs them out everywhere, so the only way back in is a password
+// reset.
+func (db *Store) ClearPassword(ctx context.Context, userID int) error {
+	hashedPassword, err := models.UnusablePassword()
+	if err != nil {
+		return err
+	}
+
+	stmt := `UPDATE users SET password = ?, session_version = session_version + 1 WHERE id = ?`
+	_, err = db.ExecContext(ctx, stmt, string(hashedPassword), userID)
+	return err
+}
@@ -0,0 +1,84 @@
+package models
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-webauthn/webauthn/webauthn"
+)
+
+// SnippetStore keeps snippets, the trash they go to when deleted, and the
+// reports made against them.
+//
+// Lookups return a nil result rather than an error when nothing matches. Every
+// method stops when ctx is done.
+type SnippetStore interface {
+	GetSnippet(ctx context.Context, id int) (*Snippet, error)
+	LatestSnippets(ctx context.Context) (Snippets, error)
+	// InsertSnippet creates a snippet that expires after the given number of
+	// seconds, and returns its ID.
+	InsertSnippet(ctx context.Context, userID int, title, content, contentType, expires string) (int, error)
+	UserSnippets(ctx context.Context, userID int) (Snippets, error)
+	DeleteSnippet(ctx context.Context, id, deletedBy int) error
+
+	TrashedSnippets(ctx context.Context, ownerID, retentionDays int) (Snippets, error)
+	RestoreSnippet(ctx context.Context, id, ownerID, retentionDays int) (bool, error)
+	PurgeSnippets(ctx context.Context, retentionDays int) (int, error)
+
+	InsertReport(ctx context.Context, snippetID, reporterID int, reason string) error
+	GetReport(ctx context.Context, id int) (*Report, error)
+	OpenReports(ctx context.Context) ([]*Report, error)
+	ResolvedReports(ctx context.Context, limit int) ([]*Report, error)
+	ResolveReports(ctx context.Context, snippetID, moderatorID int, status string) error
+	SetHidden(ctx context.Context, snippetID int, hidden bool) error
+}
+
+// UserStore keeps user accounts and everything used to log in to them, the
+// roles they're given, the site-wide security settings, and the audit log of
+// what users have done.
+//
+// Lookups return a nil result rather than an error when nothing matches. Every
+// method stops when ctx is done.
+type UserStore interface {
+	InsertUser(ctx context.Context, name, email, password string) error
+	InsertAdmin(ctx context.Context, name, email, password string) error
+	VerifyUser(ctx context.Context, email, password string) (int, error)
+	GetUser(ctx context.Context, id int) (*User, error)
+	GetUserByEmail(ctx context.Context, email string) (*User, error)
+	SearchUsers(ctx context.Context, query string, offset, limit int) ([]*User, int, error)
+	RecordLogin(ctx context.Context, userID int) error
+	SetTimezone(ctx context.Context, userID int, timezone string) error
+	SetDisabled(ctx context.Context, userID int, disabled bool) error
+	LogoutEverywhere(ctx context.Context, userID int) error
+	ClearPassword(ctx context.Context, userID int) error
+
+	Roles(ctx context.Context) ([]string, error)
+	RolePermissions(ctx context.Context, role string) (map[string]bool, error)
+	SetRole(ctx context.Context, userID int, role string) error
+
+	InsertPasswordReset(ctx context.Context, email string, ttl time.Duration) (*User, string, error)
+	PasswordResetValid(ctx context.Context, token string) (bool, error)
+	ResetPassword(ctx context.Context, token, password string) (int, error)
+
+	EnableTOTP(ctx context.Context, userID int, secret string) ([]string, error)
+	DisableTOTP(ctx context.Context, userID int) error
+	RegenerateRecoveryCodes(ctx context.Context, userID int) ([]string, error)
+	UseRecoveryCode(ctx context.Context, userID int, code string) (bool, error)
+	UseTOTPStep(ctx context.Context, userID int, step int64) (bool, error)
+	RequireAdmin2FA(ctx context.Context) (bool, error)
+	SetRequireAdmin2FA(ctx context.Context, required bool) error
+
+	InsertPasskey(ctx context.Context, userID int, name string, cred *webauthn.Credential) error
+	UserPasskeys(ctx context.Context, userID int) ([]*Passkey, error)
+	UpdatePasskey(ctx context.Context, cred *webauthn.Credential) error
+	DeletePasskey(ctx context.Context, userID, id int) error
+	InsertCeremony(ctx context.Context, data *webauthn.SessionData, ttl time.Duration) error
+	TakeCeremony(ctx context.Context, challenge string) (*webauthn.SessionData, error)
+
+	GetUserByIdentity(ctx context.Context, issuer, subject string) (*User, error)
+	LinkIdentity(ctx context.Context, userID int, issuer, subject string) error
+	InsertIdentityUser(ctx context.Context, name, email, issuer, subject string) (int, error)
+
+	InsertAudit(ctx context.Context, e *AuditEntry) error
+	AuditEntries(ctx context.Context, f *AuditFilter, offset, limit int) ([]*AuditEntry, int, error)
+}
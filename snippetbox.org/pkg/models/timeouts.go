@@ -0,0 +1,378 @@
+package models
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-webauthn/webauthn/webauthn"
+)
+
+// SnippetsWithTimeout wraps a SnippetStore so that each call is stopped if it takes
+// longer than timeout, and returns ErrTimeout or ErrCanceled when its context
+// is done rather than whatever error the driver gave. A timeout of 0 leaves
+// calls limited only by their context.
+func SnippetsWithTimeout(store SnippetStore, timeout time.Duration) SnippetStore {
+	return &snippetTimeouts{store: store, timeout: timeout}
+}
+
+// UsersWithTimeout does the same as SnippetsWithTimeout for a UserStore.
+func UsersWithTimeout(store UserStore, timeout time.Duration) UserStore {
+	return &userTimeouts{store: store, timeout: timeout}
+}
+
+// WithTimeout returns a copy of ctx that is canceled after timeout, or only
+// when ctx is if timeout is 0. Other packages' database stores use it and
+// ContextError to behave like the ones here.
+func WithTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+// ContextError replaces err with ErrTimeout or ErrCanceled if it happened
+// because ctx is done. Drivers report that in different ways, so ctx is
+// checked rather than err.
+func ContextError(ctx context.Context, err error) error {
+	if err == nil || ctx.Err() == nil {
+		return err
+	}
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return ErrTimeout
+	}
+	return ErrCanceled
+}
+
+type snippetTimeouts struct {
+	store   SnippetStore
+	timeout time.Duration
+}
+
+func (s *snippetTimeouts) GetSnippet(ctx context.Context, id int) (*Snippet, error) {
+	ctx, cancel := WithTimeout(ctx, s.timeout)
+	defer cancel()
+	result, err := s.store.GetSnippet(ctx, id)
+	return result, ContextError(ctx, err)
+}
+
+func (s *snippetTimeouts) LatestSnippets(ctx context.Context) (Snippets, error) {
+	ctx, cancel := WithTimeout(ctx, s.timeout)
+	defer cancel()
+	result, err := s.store.LatestSnippets(ctx)
+	return result, ContextError(ctx, err)
+}
+
+func (s *snippetTimeouts) InsertSnippet(ctx context.Context, userID int, title, content, contentType, expires string) (int, error) {
+	ctx, cancel := WithTimeout(ctx, s.timeout)
+	defer cancel()
+	result, err := s.store.InsertSnippet(ctx, userID, title, content, contentType, expires)
+	return result, ContextError(ctx, err)
+}
+
+func (s *snippetTimeouts) UserSnippets(ctx context.Context, userID int) (Snippets, error) {
+	ctx, cancel := WithTimeout(ctx, s.timeout)
+	defer cancel()
+	result, err := s.store.UserSnippets(ctx, userID)
+	return result, ContextError(ctx, err)
+}
+
+func (s *snippetTimeouts) DeleteSnippet(ctx context.Context, id, deletedBy int) error {
+	ctx, cancel := WithTimeout(ctx, s.timeout)
+	defer cancel()
+	return ContextError(ctx, s.store.DeleteSnippet(ctx, id, deletedBy))
+}
+
+func (s *snippetTimeouts) TrashedSnippets(ctx context.Context, ownerID, retentionDays int) (Snippets, error) {
+	ctx, cancel := WithTimeout(ctx, s.timeout)
+	defer cancel()
+	result, err := s.store.TrashedSnippets(ctx, ownerID, retentionDays)
+	return result, ContextError(ctx, err)
+}
+
+func (s *snippetTimeouts) RestoreSnippet(ctx context.Context, id, ownerID, retentionDays int) (bool, error) {
+	ctx, cancel := WithTimeout(ctx, s.timeout)
+	defer cancel()
+	result, err := s.store.RestoreSnippet(ctx, id, ownerID, retentionDays)
+	return result, ContextError(ctx, err)
+}
+
+func (s *snippetTimeouts) PurgeSnippets(ctx context.Context, retentionDays int) (int, error) {
+	ctx, cancel := WithTimeout(ctx, s.timeout)
+	defer cancel()
+	result, err := s.store.PurgeSnippets(ctx, retentionDays)
+	return result, ContextError(ctx, err)
+}
+
+func (s *snippetTimeouts) InsertReport(ctx context.Context, snippetID, reporterID int, reason string) error {
+	ctx, cancel := WithTimeout(ctx, s.timeout)
+	defer cancel()
+	return ContextError(ctx, s.store.InsertReport(ctx, snippetID, reporterID, reason))
+}
+
+func (s *snippetTimeouts) GetReport(ctx context.Context, id int) (*Report, error) {
+	ctx, cancel := WithTimeout(ctx, s.timeout)
+	defer cancel()
+	result, err := s.store.GetReport(ctx, id)
+	return result, ContextError(ctx, err)
+}
+
+func (s *snippetTimeouts) OpenReports(ctx context.Context) ([]*Report, error) {
+	ctx, cancel := WithTimeout(ctx, s.timeout)
+	defer cancel()
+	result, err := s.store.OpenReports(ctx)
+	return result, ContextError(ctx, err)
+}
+
+func (s *snippetTimeouts) ResolvedReports(ctx context.Context, limit int) ([]*Report, error) {
+	ctx, cancel := WithTimeout(ctx, s.timeout)
+	defer cancel()
+	result, err := s.store.ResolvedReports(ctx, limit)
+	return result, ContextError(ctx, err)
+}
+
+func (s *snippetTimeouts) ResolveReports(ctx context.Context, snippetID, moderatorID int, status string) error {
+	ctx, cancel := WithTimeout(ctx, s.timeout)
+	defer cancel()
+	return ContextError(ctx, s.store.ResolveReports(ctx, snippetID, moderatorID, status))
+}
+
+func (s *snippetTimeouts) SetHidden(ctx context.Context, snippetID int, hidden bool) error {
+	ctx, cancel := WithTimeout(ctx, s.timeout)
+	defer cancel()
+	return ContextError(ctx, s.store.SetHidden(ctx, snippetID, hidden))
+}
+
+type userTimeouts struct {
+	store   UserStore
+	timeout time.Duration
+}
+
+func (s *userTimeouts) InsertUser(ctx context.Context, name, email, password string) error {
+	ctx, cancel := WithTimeout(ctx, s.timeout)
+	defer cancel()
+	return ContextError(ctx, s.store.InsertUser(ctx, name, email, password))
+}
+
+func (s *userTimeouts) InsertAdmin(ctx context.Context, name, email, password string) error {
+	ctx, cancel := WithTimeout(ctx, s.timeout)
+	defer cancel()
+	return ContextError(ctx, s.store.InsertAdmin(ctx, name, email, password))
+}
+
+func (s *userTimeouts) VerifyUser(ctx context.Context, email, password string) (int, error) {
+	ctx, cancel := WithTimeout(ctx, s.timeout)
+	defer cancel()
+	result, err := s.store.VerifyUser(ctx, email, password)
+	return result, ContextError(ctx, err)
+}
+
+func (s *userTimeouts) GetUser(ctx context.Context, id int) (*User, error) {
+	ctx, cancel := WithTimeout(ctx, s.timeout)
+	defer cancel()
+	result, err := s.store.GetUser(ctx, id)
+	return result, ContextError(ctx, err)
+}
+
+func (s *userTimeouts) GetUserByEmail(ctx context.Context, email string) (*User, error) {
+	ctx, cancel := WithTimeout(ctx, s.timeout)
+	defer cancel()
+	result, err := s.store.GetUserByEmail(ctx, email)
+	return result, ContextError(ctx, err)
+}
+
+func (s *userTimeouts) SearchUsers(ctx context.Context, query string, offset, limit int) ([]*User, int, error) {
+	ctx, cancel := WithTimeout(ctx, s.timeout)
+	defer cancel()
+	users, total, err := s.store.SearchUsers(ctx, query, offset, limit)
+	return users, total, ContextError(ctx, err)
+}
+
+func (s *userTimeouts) RecordLogin(ctx context.Context, userID int) error {
+	ctx, cancel := WithTimeout(ctx, s.timeout)
+	defer cancel()
+	return ContextError(ctx, s.store.RecordLogin(ctx, userID))
+}
+
+func (s *userTimeouts) SetTimezone(ctx context.Context, userID int, timezone string) error {
+	ctx, cancel := WithTimeout(ctx, s.timeout)
+	defer cancel()
+	return ContextError(ctx, s.store.SetTimezone(ctx, userID, timezone))
+}
+
+func (s *userTimeouts) SetDisabled(ctx context.Context, userID int, disabled bool) error {
+	ctx, cancel := WithTimeout(ctx, s.timeout)
+	defer cancel()
+	return ContextError(ctx, s.store.SetDisabled(ctx, userID, disabled))
+}
+
+func (s *userTimeouts) LogoutEverywhere(ctx context.Context, userID int) error {
+	ctx, cancel := WithTimeout(ctx, s.timeout)
+	defer cancel()
+	return ContextError(ctx, s.store.LogoutEverywhere(ctx, userID))
+}
+
+func (s *userTimeouts) ClearPassword(ctx context.Context, userID int) error {
+	ctx, cancel := WithTimeout(ctx, s.timeout)
+	defer cancel()
+	return ContextError(ctx, s.store.ClearPassword(ctx, userID))
+}
+
+func (s *userTimeouts) Roles(ctx context.Context) ([]string, error) {
+	ctx, cancel := WithTimeout(ctx, s.timeout)
+	defer cancel()
+	result, err := s.store.Roles(ctx)
+	return result, ContextError(ctx, err)
+}
+
+func (s *userTimeouts) RolePermissions(ctx context.Context, role string) (map[string]bool, error) {
+	ctx, cancel := WithTimeout(ctx, s.timeout)
+	defer cancel()
+	result, err := s.store.RolePermissions(ctx, role)
+	return result, ContextError(ctx, err)
+}
+
+func (s *userTimeouts) SetRole(ctx context.Context, userID int, role string) error {
+	ctx, cancel := WithTimeout(ctx, s.timeout)
+	defer cancel()
+	return ContextError(ctx, s.store.SetRole(ctx, userID, role))
+}
+
+func (s *userTimeouts) InsertPasswordReset(ctx context.Context, email string, ttl time.Duration) (*User, string, error) {
+	ctx, cancel := WithTimeout(ctx, s.timeout)
+	defer cancel()
+	u, token, err := s.store.InsertPasswordReset(ctx, email, ttl)
+	return u, token, ContextError(ctx, err)
+}
+
+func (s *userTimeouts) PasswordResetValid(ctx context.Context, token string) (bool, error) {
+	ctx, cancel := WithTimeout(ctx, s.timeout)
+	defer cancel()
+	result, err := s.store.PasswordResetValid(ctx, token)
+	return result, ContextError(ctx, err)
+}
+
+func (s *userTimeouts) ResetPassword(ctx context.Context, token, password string) (int, error) {
+	ctx, cancel := WithTimeout(ctx, s.timeout)
+	defer cancel()
+	result, err := s.store.ResetPassword(ctx, token, password)
+	return result, ContextError(ctx, err)
+}
+
+func (s *userTimeouts) EnableTOTP(ctx context.Context, userID int, secret string) ([]string, error) {
+	ctx, cancel := WithTimeout(ctx, s.timeout)
+	defer cancel()
+	result, err := s.store.EnableTOTP(ctx, userID, secret)
+	return result, ContextError(ctx, err)
+}
+
+func (s *userTimeouts) DisableTOTP(ctx context.Context, userID int) error {
+	ctx, cancel := WithTimeout(ctx, s.timeout)
+	defer cancel()
+	return ContextError(ctx, s.store.DisableTOTP(ctx, userID))
+}
+
+func (s *userTimeouts) RegenerateRecoveryCodes(ctx context.Context, userID int) ([]string, error) {
+	ctx, cancel := WithTimeout(ctx, s.timeout)
+	defer cancel()
+	result, err := s.store.RegenerateRecoveryCodes(ctx, userID)
+	return result, ContextError(ctx, err)
+}
+
+func (s *userTimeouts) UseRecoveryCode(ctx context.Context, userID int, code string) (bool, error) {
+	ctx, cancel := WithTimeout(ctx, s.timeout)
+	defer cancel()
+	result, err := s.store.UseRecoveryCode(ctx, userID, code)
+	return result, ContextError(ctx, err)
+}
+
+func (s *userTimeouts) UseTOTPStep(ctx context.Context, userID int, step int64) (bool, error) {
+	ctx, cancel := WithTimeout(ctx, s.timeout)
+	defer cancel()
+	result, err := s.store.UseTOTPStep(ctx, userID, step)
+	return result, ContextError(ctx, err)
+}
+
+func (s *userTimeouts) RequireAdmin2FA(ctx context.Context) (bool, error) {
+	ctx, cancel := WithTimeout(ctx, s.timeout)
+	defer cancel()
+	result, err := s.store.RequireAdmin2FA(ctx)
+	return result, ContextError(ctx, err)
+}
+
+func (s *userTimeouts) SetRequireAdmin2FA(ctx context.Context, required bool) error {
+	ctx, cancel := WithTimeout(ctx, s.timeout)
+	defer cancel()
+	return ContextError(ctx, s.store.SetRequireAdmin2FA(ctx, required))
+}
+
+func (s *userTimeouts) InsertPasskey(ctx context.Context, userID int, name string, cred *webauthn.Credential) error {
+	ctx, cancel := WithTimeout(ctx, s.timeout)
+	defer cancel()
+	return ContextError(ctx, s.store.InsertPasskey(ctx, userID, name, cred))
+}
+
+func (s *userTimeouts) UserPasskeys(ctx context.Context, userID int) ([]*Passkey, error) {
+	ctx, cancel := WithTimeout(ctx, s.timeout)
+	defer cancel()
+	result, err := s.store.UserPasskeys(ctx, userID)
+	return result, ContextError(ctx, err)
+}
+
+func (s *userTimeouts) UpdatePasskey(ctx context.Context, cred *webauthn.Credential) error {
+	ctx, cancel := WithTimeout(ctx, s.timeout)
+	defer cancel()
+	return ContextError(ctx, s.store.UpdatePasskey(ctx, cred))
+}
+
+func (s *userTimeouts) DeletePasskey(ctx context.Context, userID, id int) error {
+	ctx, cancel := WithTimeout(ctx, s.timeout)
+	defer cancel()
+	return ContextError(ctx, s.store.DeletePasskey(ctx, userID, id))
+}
+
+func (s *userTimeouts) InsertCeremony(ctx context.Context, data *webauthn.SessionData, ttl time.Duration) error {
+	ctx, cancel := WithTimeout(ctx, s.timeout)
+	defer cancel()
+	return ContextError(ctx, s.store.InsertCeremony(ctx, data, ttl))
+}
+
+func (s *userTimeouts) TakeCeremony(ctx context.Context, challenge string) (*webauthn.SessionData, error) {
+	ctx, cancel := WithTimeout(ctx, s.timeout)
+	defer cancel()
+	result, err := s.store.TakeCeremony(ctx, challenge)
+	return result, ContextError(ctx, err)
+}
+
+func (s *userTimeouts) GetUserByIdentity(ctx context.Context, issuer, subject string) (*User, error) {
+	ctx, cancel := WithTimeout(ctx, s.timeout)
+	defer cancel()
+	result, err := s.store.GetUserByIdentity(ctx, issuer, subject)
+	return result, ContextError(ctx, err)
+}
+
+func (s *userTimeouts) LinkIdentity(ctx context.Context, userID int, issuer, subject string) error {
+	ctx, cancel := WithTimeout(ctx, s.timeout)
+	defer cancel()
+	return ContextError(ctx, s.store.LinkIdentity(ctx, userID, issuer, subject))
+}
+
+func (s *userTimeouts) InsertIdentityUser(ctx context.Context, name, email, issuer, subject string) (int, error) {
+	ctx, cancel := WithTimeout(ctx, s.timeout)
+	defer cancel()
+	result, err := s.store.InsertIdentityUser(ctx, name, email, issuer, subject)
+	return result, ContextError(ctx, err)
+}
+
+func (s *userTimeouts) InsertAudit(ctx context.Context, e *AuditEntry) error {
+	ctx, cancel := WithTimeout(ctx, s.timeout)
+	defer cancel()
+	return ContextError(ctx, s.store.InsertAudit(ctx, e))
+}
+
+func (s *userTimeouts) AuditEntries(ctx context.Context, f *AuditFilter, offset, limit int) ([]*AuditEntry, int, error) {
+	ctx, cancel := WithTimeout(ctx, s.timeout)
+	defer cancel()
+	entries, total, err := s.store.AuditEntries(ctx, f, offset, limit)
+	return entries, total, ContextError(ctx, err)
+}
@@ -0,0 +1,51 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// How many takes MemStore allows between sweeps for full buckets.
+const sweepEvery = 1000
+
+// MemStore keeps buckets in memory. It's fast, but buckets aren't shared
+// between instances of the application, so each instance allows the full
+// limit.
+type MemStore struct {
+	mu      sync.Mutex
+	buckets map[string]memBucket
+	takes   int
+}
+
+type memBucket struct {
+	Bucket
+	full time.Time
+}
+
+func NewMemStore() *MemStore {
+	return &MemStore{buckets: make(map[string]memBucket)}
+}
+
+func (m *MemStore) Take(ctx context.Context, key string, l Limit, now time.Time) (bool, time.Duration, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// A full bucket is the same as no bucket, so forget them now and then to
+	// stop the map growing with every client ever seen.
+	m.takes++
+	if m.takes >= sweepEvery {
+		m.takes = 0
+		for k, b := range m.buckets {
+			if !b.full.After(now) {
+				delete(m.buckets, k)
+			}
+		}
+	}
+
+	mb, found := m.buckets[key]
+	b, ok, wait := take(mb.Bucket, found, l, now)
+	m.buckets[key] = memBucket{Bucket: b, full: full(b, l)}
+
+	return ok, wait, nil
+}
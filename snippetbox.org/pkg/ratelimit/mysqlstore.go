@@ -0,0 +1,60 @@
+package ratelimit
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+// MySQLStore keeps buckets in the rate_limits table, so every instance using
+// the same database shares one limit.
+type MySQLStore struct {
+	DB *sql.DB
+}
+
+func NewMySQLStore(db *sql.DB) *MySQLStore {
+	return &MySQLStore{DB: db}
+}
+
+func (m *MySQLStore) Take(ctx context.Context, key string, l Limit, now time.Time) (bool, time.Duration, error) {
+	tx, err := m.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return false, 0, err
+	}
+	defer tx.Rollback()
+
+	// Make sure there's a row to lock first, as locking a missing row only
+	// locks the gap, and two requests inserting into it would deadlock. A new
+	// row has a full bucket, the same as no row.
+	stmt := `INSERT INTO rate_limits (bucket_key, tokens, updated, full_at) VALUES(?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE bucket_key = bucket_key`
+	_, err = tx.ExecContext(ctx, stmt, key, float64(l.Burst), now.UTC(), now.UTC())
+	if err != nil {
+		return false, 0, err
+	}
+
+	// Lock the row so that concurrent requests for the key take turns.
+	b := Bucket{}
+	stmt = `SELECT tokens, updated FROM rate_limits WHERE bucket_key = ? FOR UPDATE`
+	err = tx.QueryRowContext(ctx, stmt, key).Scan(&b.Tokens, &b.Updated)
+	if err != nil {
+		return false, 0, err
+	}
+
+	b, ok, wait := take(b, true, l, now)
+
+	stmt = `UPDATE rate_limits SET tokens = ?, updated = ?, full_at = ? WHERE bucket_key = ?`
+	_, err = tx.ExecContext(ctx, stmt, b.Tokens, b.Updated.UTC(), full(b, l).UTC(), key)
+	if err != nil {
+		return false, 0, err
+	}
+
+	return ok, wait, tx.Commit()
+}
+
+// Prune deletes buckets that have refilled completely, since they're the same
+// as no bucket at all.
+func (m *MySQLStore) Prune(ctx context.Context, now time.Time) error {
+	_, err := m.DB.ExecContext(ctx, "DELETE FROM rate_limits WHERE full_at <= ?", now.UTC())
+	return err
+}
@@ -0,0 +1,108 @@
+// Package ratelimit limits how often clients can make requests, using token
+// buckets.
+//
+// Each key, such as a user ID or client IP, has a bucket holding up to Burst
+// tokens that refills at Rate tokens per second. Every request takes a token,
+// and requests that find the bucket empty are refused until it refills. The
+// buckets live in a pluggable Store.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Limit is a refill rate and bucket size.
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+// Per returns a limit allowing n requests in each period d, which can all be
+// made at once.
+func Per(n int, d time.Duration) Limit {
+	return Limit{Rate: float64(n) / d.Seconds(), Burst: n}
+}
+
+// ParseLimit parses a limit written as "n/duration", such as "30/1m".
+func ParseLimit(s string) (Limit, error) {
+	parts := strings.SplitN(s, "/", 2)
+	if len(parts) != 2 {
+		return Limit{}, fmt.Errorf("ratelimit: limit %q isn't of the form n/duration", s)
+	}
+
+	n, err := strconv.Atoi(parts[0])
+	if err != nil || n < 1 {
+		return Limit{}, fmt.Errorf("ratelimit: limit %q needs a positive count", s)
+	}
+
+	d, err := time.ParseDuration(parts[1])
+	if err != nil || d <= 0 {
+		return Limit{}, fmt.Errorf("ratelimit: limit %q needs a positive duration", s)
+	}
+
+	return Per(n, d), nil
+}
+
+// Bucket is the state of a single key's token bucket.
+type Bucket struct {
+	Tokens  float64
+	Updated time.Time
+}
+
+// Store is the interface for token bucket stores.
+type Store interface {
+	// Take should refill the key's bucket for the time since it was last
+	// updated, then take a token from it if there is one. It should return
+	// whether a token was taken and, if not, how long until one will be. A key
+	// that is not found has a full bucket. Take must be atomic for each key.
+	Take(ctx context.Context, key string, l Limit, now time.Time) (ok bool, wait time.Duration, err error)
+}
+
+// take applies the token bucket rules to b, which is a full bucket if found is
+// false, and returns the updated bucket.
+func take(b Bucket, found bool, l Limit, now time.Time) (Bucket, bool, time.Duration) {
+	if !found {
+		b = Bucket{Tokens: float64(l.Burst), Updated: now}
+	}
+
+	if elapsed := now.Sub(b.Updated).Seconds(); elapsed > 0 {
+		b.Tokens = math.Min(float64(l.Burst), b.Tokens+elapsed*l.Rate)
+	}
+	b.Updated = now
+
+	if b.Tokens < 1 {
+		wait := time.Duration((1 - b.Tokens) / l.Rate * float64(time.Second))
+		return b, false, wait
+	}
+
+	b.Tokens--
+	return b, true, 0
+}
+
+// full returns when the bucket will have refilled completely.
+func full(b Bucket, l Limit) time.Time {
+	return b.Updated.Add(time.Duration((float64(l.Burst) - b.Tokens) / l.Rate * float64(time.Second)))
+}
+
+// Limiter applies a limit to the buckets in a Store. Its name keeps its
+// buckets apart from those of other limiters sharing the store.
+type Limiter struct {
+	Name  string
+	Limit Limit
+	Store Store
+}
+
+func New(name string, l Limit, store Store) *Limiter {
+	return &Limiter{Name: name, Limit: l, Store: store}
+}
+
+// Allow takes a token for the key. If there isn't one, it returns false and how
+// long the key has to wait before trying again.
+func (l *Limiter) Allow(ctx context.Context, key string) (bool, time.Duration, error) {
+	return l.Store.Take(ctx, l.Name+":"+key, l.Limit, time.Now())
+}
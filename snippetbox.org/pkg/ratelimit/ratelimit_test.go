@@ -0,0 +1,90 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestParseLimit(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Limit
+		wantErr bool
+	}{
+		{"30/1m", Limit{Rate: 0.5, Burst: 30}, false},
+		{"3/24h", Limit{Rate: 3.0 / 86400, Burst: 3}, false},
+		{"10/1s", Limit{Rate: 10, Burst: 10}, false},
+		{"30", Limit{}, true},
+		{"0/1m", Limit{}, true},
+		{"-1/1m", Limit{}, true},
+		{"x/1m", Limit{}, true},
+		{"30/0s", Limit{}, true},
+		{"30/minute", Limit{}, true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseLimit(tt.in)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("ParseLimit(%q) = %+v, %v; want %+v, error %v", tt.in, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestTake(t *testing.T) {
+	l := Per(2, 2*time.Second)
+	now := time.Now()
+
+	tests := []struct {
+		name     string
+		b        Bucket
+		found    bool
+		wantOK   bool
+		wantWait time.Duration
+		wantLeft float64
+	}{
+		{"New key", Bucket{}, false, true, 0, 1},
+		{"Has a token", Bucket{Tokens: 1, Updated: now}, true, true, 0, 0},
+		{"Empty", Bucket{Tokens: 0, Updated: now}, true, false, time.Second, 0},
+		{"Half refilled", Bucket{Tokens: 0, Updated: now.Add(-500 * time.Millisecond)}, true, false, 500 * time.Millisecond, 0.5},
+		{"Refilled", Bucket{Tokens: 0, Updated: now.Add(-time.Second)}, true, true, 0, 0},
+		{"Capped at burst", Bucket{Tokens: 0, Updated: now.Add(-time.Hour)}, true, true, 0, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, ok, wait := take(tt.b, tt.found, l, now)
+			if ok != tt.wantOK || wait != tt.wantWait {
+				t.Errorf("got %v, %v; want %v, %v", ok, wait, tt.wantOK, tt.wantWait)
+			}
+			if b.Tokens != tt.wantLeft || !b.Updated.Equal(now) {
+				t.Errorf("got bucket %+v; want %v tokens updated now", b, tt.wantLeft)
+			}
+		})
+	}
+}
+
+func TestLimiter(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemStore()
+	auth := New("auth", Per(2, time.Minute), store)
+	write := New("write", Per(1, time.Minute), store)
+
+	for i, want := range []bool{true, true, false} {
+		ok, _, err := auth.Allow(ctx, "1.2.3.4")
+		if err != nil || ok != want {
+			t.Errorf("auth request %d: got %v, %v; want %v, nil", i+1, ok, err, want)
+		}
+	}
+
+	// Limiters sharing the store keep separate buckets, as do keys.
+	ok, _, err := write.Allow(ctx, "1.2.3.4")
+	if err != nil || !ok {
+		t.Errorf("write request: got %v, %v; want true, nil", ok, err)
+	}
+
+	ok, _, err = auth.Allow(ctx, "5.6.7.8")
+	if err != nil || !ok {
+		t.Errorf("auth request from another key: got %v, %v; want true, nil", ok, err)
+	}
+}
@@ -0,0 +1,27 @@
+package ratelimit
+
+import (
+	"context"
+	"time"
+
+	"snippetbox.org/pkg/models"
+)
+
+// WithTimeout wraps a Store so that each take is stopped if it takes longer
+// than timeout, returning models.ErrTimeout or models.ErrCanceled like the
+// model stores do. A timeout of 0 leaves takes limited only by their context.
+func WithTimeout(store Store, timeout time.Duration) Store {
+	return &storeTimeouts{store: store, timeout: timeout}
+}
+
+type storeTimeouts struct {
+	store   Store
+	timeout time.Duration
+}
+
+func (s *storeTimeouts) Take(ctx context.Context, key string, l Limit, now time.Time) (bool, time.Duration, error) {
+	ctx, cancel := models.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	ok, wait, err := s.store.Take(ctx, key, l, now)
+	return ok, wait, models.ContextError(ctx, err)
+}
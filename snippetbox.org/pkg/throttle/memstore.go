@@ -0,0 +1,90 @@
+package throttle
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// How many updates MemStore allows between sweeps for expired entries.
+const sweepEvery = 1000
+
+// MemStore keeps throttle state in memory. It's fast, but the state is lost on
+// restart and isn't shared between instances of the application.
+type MemStore struct {
+	mu      sync.Mutex
+	entries map[string]Entry
+	ttl     time.Duration
+	updates int
+}
+
+// NewMemStore returns a MemStore that forgets an entry once ttl has passed
+// since its last failure and it isn't locked out. The ttl should be at least
+// the Window of the throttles using the store.
+func NewMemStore(ttl time.Duration) *MemStore {
+	return &MemStore{entries: make(map[string]Entry), ttl: ttl}
+}
+
+// expired reports whether the entry can be forgotten.
+func (m *MemStore) expired(e Entry, now time.Time) bool {
+	return now.Sub(e.LastFailure) > m.ttl && !e.LockedUntil.After(now)
+}
+
+func (m *MemStore) Find(ctx context.Context, key string) (Entry, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	e, found := m.entries[key]
+	if found && m.expired(e, time.Now()) {
+		delete(m.entries, key)
+		return Entry{}, false, nil
+	}
+	return e, found, nil
+}
+
+func (m *MemStore) Update(ctx context.Context, key string, fn func(e Entry) Entry) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// Sweep out expired entries now and then to stop the map growing with
+	// every key that has ever failed.
+	now := time.Now()
+	m.updates++
+	if m.updates >= sweepEvery {
+		m.updates = 0
+		for k, old := range m.entries {
+			if m.expired(old, now) {
+				delete(m.entries, k)
+			}
+		}
+	}
+
+	e, found := m.entries[key]
+	if found && m.expired(e, now) {
+		e = Entry{}
+	}
+
+	m.entries[key] = fn(e)
+	return nil
+}
+
+func (m *MemStore) Delete(ctx context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.entries, key)
+	return nil
+}
+
+func (m *MemStore) Locked(ctx context.Context, now time.Time) (map[string]Entry, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	locked := make(map[string]Entry)
+	for key, e := range m.entries {
+		if e.LockedUntil.After(now) {
+			locked[key] = e
+		}
+	}
+	return locked, nil
+}
@@ -0,0 +1,116 @@
+package throttle
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+// MySQLStore keeps throttle state in the login_throttle table, so it survives
+// restarts and is shared by every instance using the same database.
+type MySQLStore struct {
+	DB *sql.DB
+}
+
+func NewMySQLStore(db *sql.DB) *MySQLStore {
+	return &MySQLStore{DB: db}
+}
+
+func (m *MySQLStore) Find(ctx context.Context, key string) (Entry, bool, error) {
+	stmt := `SELECT failures, last_failure, locked_until FROM login_throttle WHERE throttle_key = ?`
+
+	e := Entry{}
+	var lockedUntil sql.NullTime
+	err := m.DB.QueryRowContext(ctx, stmt, key).Scan(&e.Failures, &e.LastFailure, &lockedUntil)
+	if err == sql.ErrNoRows {
+		return Entry{}, false, nil
+	} else if err != nil {
+		return Entry{}, false, err
+	}
+
+	e.LockedUntil = lockedUntil.Time
+	return e, true, nil
+}
+
+func (m *MySQLStore) Update(ctx context.Context, key string, fn func(e Entry) Entry) error {
+	tx, err := m.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// Make sure there's a row to lock first, as locking a missing row only
+	// locks the gap, and two instances inserting into it would deadlock. A row
+	// with no failures stands for no entry.
+	stmt := `INSERT INTO login_throttle (throttle_key, failures, last_failure) VALUES(?, 0, ?)
+ON DUPLICATE KEY UPDATE throttle_key = throttle_key`
+	_, err = tx.ExecContext(ctx, stmt, key, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+
+	// Lock the row so that concurrent failures for the key take turns.
+	e := Entry{}
+	var lockedUntil sql.NullTime
+	stmt = `SELECT failures, last_failure, locked_until FROM login_throttle WHERE throttle_key = ? FOR UPDATE`
+	err = tx.QueryRowContext(ctx, stmt, key).Scan(&e.Failures, &e.LastFailure, &lockedUntil)
+	if err != nil {
+		return err
+	}
+	e.LockedUntil = lockedUntil.Time
+	if e.Failures == 0 {
+		e = Entry{}
+	}
+
+	e = fn(e)
+
+	lockedUntil = sql.NullTime{}
+	if !e.LockedUntil.IsZero() {
+		lockedUntil = sql.NullTime{Time: e.LockedUntil.UTC(), Valid: true}
+	}
+
+	stmt = `UPDATE login_throttle SET failures = ?, last_failure = ?, locked_until = ? WHERE throttle_key = ?`
+	_, err = tx.ExecContext(ctx, stmt, e.Failures, e.LastFailure.UTC(), lockedUntil, key)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (m *MySQLStore) Delete(ctx context.Context, key string) error {
+	_, err := m.DB.ExecContext(ctx, "DELETE FROM login_throttle WHERE throttle_key = ?", key)
+	return err
+}
+
+func (m *MySQLStore) Locked(ctx context.Context, now time.Time) (map[string]Entry, error) {
+	stmt := `SELECT throttle_key, failures, last_failure, locked_until FROM login_throttle WHERE locked_until > ?`
+
+	rows, err := m.DB.QueryContext(ctx, stmt, now.UTC())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	locked := make(map[string]Entry)
+	for rows.Next() {
+		var key string
+		e := Entry{}
+		err := rows.Scan(&key, &e.Failures, &e.LastFailure, &e.LockedUntil)
+		if err != nil {
+			return nil, err
+		}
+		locked[key] = e
+	}
+
+	return locked, rows.Err()
+}
+
+// Prune deletes entries whose last failure was before the cutoff and whose
+// lockout, if any, had ended by then. With the cutoff at least the throttles'
+// Window ago, they're the same as no entry at all.
+func (m *MySQLStore) Prune(ctx context.Context, before time.Time) error {
+	stmt := `DELETE FROM login_throttle WHERE last_failure < ? AND (locked_until IS NULL OR locked_until < ?)`
+	_, err := m.DB.ExecContext(ctx, stmt, before.UTC(), before.UTC())
+	return err
+}
@@ -0,0 +1,153 @@
+// Package throttle slows down and locks out repeated failed login attempts.
+//
+// Failures are counted per key, such as an account's email or a client IP.
+// After a few free attempts each further failure doubles the time the key has
+// to wait before trying again, and once the threshold is reached the key is
+// locked out for a fixed period. The counts live in a pluggable Store.
+package throttle
+
+import (
+	"context"
+	"time"
+)
+
+// Entry is the throttling state for a single key.
+type Entry struct {
+	Failures    int
+	LastFailure time.Time
+	LockedUntil time.Time
+}
+
+// Store is the interface for throttle state stores.
+type Store interface {
+	// Find should return the entry for a key. If the key is not found, the found
+	// return value should be false and the err return value nil.
+	Find(ctx context.Context, key string) (e Entry, found bool, err error)
+
+	// Update should replace the entry for a key with the result of fn, which
+	// is given the current entry, or a zero Entry if there's none. Finding
+	// and saving the entry must be atomic, including between instances
+	// sharing the store, so that concurrent failures aren't lost.
+	Update(ctx context.Context, key string, fn func(e Entry) Entry) (err error)
+
+	// Delete should remove the entry for a key. If the key does not exist then
+	// Delete should be a no-op and return nil.
+	Delete(ctx context.Context, key string) (err error)
+
+	// Locked should return all entries that are locked out at the given time,
+	// keyed by their key.
+	Locked(ctx context.Context, now time.Time) (entries map[string]Entry, err error)
+}
+
+// Throttle applies backoff and lockout rules to the entries in a Store.
+type Throttle struct {
+	Store Store
+
+	// FreeAttempts is how many failures are allowed before any delay applies.
+	FreeAttempts int
+
+	// BaseDelay is the delay after the first failure beyond FreeAttempts. It
+	// doubles with each further failure, up to MaxDelay.
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+
+	// Threshold is the number of failures that locks the key out for Lockout.
+	Threshold int
+	Lockout   time.Duration
+
+	// Window is how long after the last failure the count is forgotten.
+	Window time.Duration
+}
+
+// New returns a Throttle using the given store, with defaults suited to
+// protecting a single account: three free attempts, a delay starting at one
+// second and capped at five minutes, and a 30 minute lockout after ten
+// failures within an hour.
+func New(store Store) *Throttle {
+	return &Throttle{
+		Store:        store,
+		FreeAttempts: 3,
+		BaseDelay:    time.Second,
+		MaxDelay:     5 * time.Minute,
+		Threshold:    10,
+		Lockout:      30 * time.Minute,
+		Window:       time.Hour,
+	}
+}
+
+// Wait returns how long the key has to wait before it may try again. A zero
+// duration means it may try now.
+func (t *Throttle) Wait(ctx context.Context, key string) (time.Duration, error) {
+	e, found, err := t.Store.Find(ctx, key)
+	if err != nil || !found {
+		return 0, err
+	}
+
+	now := time.Now()
+	if e.LockedUntil.After(now) {
+		return e.LockedUntil.Sub(now), nil
+	}
+
+	next := e.LastFailure.Add(t.delay(e.Failures))
+	if next.After(now) {
+		return next.Sub(now), nil
+	}
+
+	return 0, nil
+}
+
+// delay returns the backoff after the given number of failures.
+func (t *Throttle) delay(failures int) time.Duration {
+	n := failures - t.FreeAttempts
+	if n <= 0 {
+		return 0
+	}
+
+	d := t.BaseDelay
+	for i := 1; i < n && d < t.MaxDelay; i++ {
+		d *= 2
+	}
+	if d > t.MaxDelay {
+		d = t.MaxDelay
+	}
+
+	return d
+}
+
+// Fail records a failed attempt for the key. It returns true if this failure
+// locked the key out.
+func (t *Throttle) Fail(ctx context.Context, key string) (bool, error) {
+	locked := false
+	err := t.Store.Update(ctx, key, func(e Entry) Entry {
+		// Start counting again once the failures are old enough to forget, or
+		// once a lockout has run out, so that the next failure after a lockout
+		// doesn't lock the key straight back out.
+		now := time.Now()
+		lockoutOver := !e.LockedUntil.IsZero() && !e.LockedUntil.After(now)
+		if lockoutOver || (now.Sub(e.LastFailure) > t.Window && !e.LockedUntil.After(now)) {
+			e = Entry{}
+		}
+
+		e.Failures++
+		e.LastFailure = now
+
+		locked = false
+		if e.Failures >= t.Threshold && !e.LockedUntil.After(now) {
+			e.LockedUntil = now.Add(t.Lockout)
+			locked = true
+		}
+		return e
+	})
+
+	return locked, err
+}
+
+// Reset forgets all failures for the key, which also lifts any lockout.
+func (t *Throttle) Reset(ctx context.Context, key string) error {
+	return t.Store.Delete(ctx, key)
+}
+
+// Locked returns the keys that are currently locked out.
+func (t *Throttle) Locked(ctx context.Context) (map[string]Entry, error) {
+	return t.Store.Locked(ctx, time.Now())
+}
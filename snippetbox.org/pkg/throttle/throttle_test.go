@@ -0,0 +1,176 @@
+package throttle
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+// save sets the entry for a key.
+func save(s Store, key string, e Entry) {
+	s.Update(context.Background(), key, func(Entry) Entry { return e })
+}
+
+func newTestThrottle() *Throttle {
+	t := New(NewMemStore(time.Hour))
+	t.FreeAttempts = 2
+	t.Threshold = 5
+	return t
+}
+
+func TestDelay(t *testing.T) {
+	th := newTestThrottle()
+	th.MaxDelay = 10 * time.Second
+
+	tests := []struct {
+		failures int
+		want     time.Duration
+	}{
+		{0, 0},
+		{2, 0},
+		{3, time.Second},
+		{4, 2 * time.Second},
+		{6, 8 * time.Second},
+		{7, 10 * time.Second},
+		{100, 10 * time.Second},
+	}
+
+	for _, tt := range tests {
+		if got := th.delay(tt.failures); got != tt.want {
+			t.Errorf("delay(%d) = %v; want %v", tt.failures, got, tt.want)
+		}
+	}
+}
+
+func TestFail(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	tests := []struct {
+		name       string
+		entry      *Entry
+		wantLocked bool
+		wantCount  int
+	}{
+		{"New key", nil, false, 1},
+		{"Counting", &Entry{Failures: 2, LastFailure: now}, false, 3},
+		{"Reaches threshold", &Entry{Failures: 4, LastFailure: now}, true, 5},
+		{"Already locked", &Entry{Failures: 5, LastFailure: now, LockedUntil: now.Add(time.Minute)}, false, 6},
+		{"Outside window", &Entry{Failures: 4, LastFailure: now.Add(-2 * time.Hour)}, false, 1},
+		{"Lockout over", &Entry{Failures: 9, LastFailure: now.Add(-time.Minute), LockedUntil: now.Add(-time.Second)}, false, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			th := newTestThrottle()
+			if tt.entry != nil {
+				save(th.Store, "key", *tt.entry)
+			}
+
+			locked, err := th.Fail(ctx, "key")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if locked != tt.wantLocked {
+				t.Errorf("got locked %v; want %v", locked, tt.wantLocked)
+			}
+
+			e, _, _ := th.Store.Find(ctx, "key")
+			if e.Failures != tt.wantCount {
+				t.Errorf("got %d failures; want %d", e.Failures, tt.wantCount)
+			}
+		})
+	}
+}
+
+func TestWaitAndReset(t *testing.T) {
+	ctx := context.Background()
+	th := newTestThrottle()
+
+	for i := 0; i < th.Threshold; i++ {
+		_, err := th.Fail(ctx, "key")
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	wait, err := th.Wait(ctx, "key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if wait <= th.Lockout-time.Minute || wait > th.Lockout {
+		t.Errorf("got wait %v after locking out; want about %v", wait, th.Lockout)
+	}
+
+	locked, err := th.Locked(ctx)
+	if err != nil || len(locked) != 1 {
+		t.Errorf("Locked: got %v, %v; want one entry", locked, err)
+	}
+
+	err = th.Reset(ctx, "key")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wait, err = th.Wait(ctx, "key")
+	if err != nil || wait != 0 {
+		t.Errorf("Wait after Reset: got %v, %v; want 0, nil", wait, err)
+	}
+}
+
+func TestMemStoreExpiry(t *testing.T) {
+	ctx := context.Background()
+	m := NewMemStore(time.Hour)
+	now := time.Now()
+
+	save(m, "old", Entry{Failures: 1, LastFailure: now.Add(-2 * time.Hour)})
+	save(m, "locked", Entry{Failures: 10, LastFailure: now.Add(-2 * time.Hour), LockedUntil: now.Add(time.Minute)})
+	save(m, "recent", Entry{Failures: 1, LastFailure: now})
+
+	for i := 0; i < sweepEvery; i++ {
+		save(m, "recent", Entry{Failures: 1, LastFailure: now})
+	}
+
+	if _, found := m.entries["old"]; found {
+		t.Error("the sweep kept an expired entry")
+	}
+
+	tests := []struct {
+		key   string
+		found bool
+	}{
+		{"old", false},
+		{"locked", true},
+		{"recent", true},
+	}
+
+	for _, tt := range tests {
+		_, found, err := m.Find(ctx, tt.key)
+		if err != nil || found != tt.found {
+			t.Errorf("Find(%q): got %v, %v; want %v, nil", tt.key, found, err, tt.found)
+		}
+	}
+}
+
+// Failures from many requests at once must all be counted.
+func TestConcurrentFail(t *testing.T) {
+	ctx := context.Background()
+	th := newTestThrottle()
+	th.Threshold = 1000
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			th.Fail(ctx, "key")
+		}()
+	}
+	wg.Wait()
+
+	e, _, err := th.Store.Find(ctx, "key")
+	if err != nil || e.Failures != 50 {
+		t.Errorf("got %d failures, %v; want 50, nil", e.Failures, err)
+	}
+}
@@ -0,0 +1,46 @@
+package throttle
+
+import (
+	"context"
+	"time"
+
+	"snippetbox.org/pkg/models"
+)
+
+// WithTimeout wraps a Store so that each call is stopped if it takes longer
+// than timeout, returning models.ErrTimeout or models.ErrCanceled like the
+// model stores do. A timeout of 0 leaves calls limited only by their context.
+func WithTimeout(store Store, timeout time.Duration) Store {
+	return &storeTimeouts{store: store, timeout: timeout}
+}
+
+type storeTimeouts struct {
+	store   Store
+	timeout time.Duration
+}
+
+func (s *storeTimeouts) Find(ctx context.Context, key string) (Entry, bool, error) {
+	ctx, cancel := models.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	e, found, err := s.store.Find(ctx, key)
+	return e, found, models.ContextError(ctx, err)
+}
+
+func (s *storeTimeouts) Update(ctx context.Context, key string, fn func(e Entry) Entry) error {
+	ctx, cancel := models.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	return models.ContextError(ctx, s.store.Update(ctx, key, fn))
+}
+
+func (s *storeTimeouts) Delete(ctx context.Context, key string) error {
+	ctx, cancel := models.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	return models.ContextError(ctx, s.store.Delete(ctx, key))
+}
+
+func (s *storeTimeouts) Locked(ctx context.Context, now time.Time) (map[string]Entry, error) {
+	ctx, cancel := models.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	result, err := s.store.Locked(ctx, now)
+	return result, models.ContextError(ctx, err)
+}
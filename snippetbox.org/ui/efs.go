@@ -0,0 +1,8 @@
+// Package ui embeds the templates, static files and translations, so the
+// binary can serve them without a copy of this directory alongside it.
+package ui
+
+import "embed"
+
+//go:embed html locales static
+var Files embed.FS
@@ -0,0 +1,110 @@
+// Browser side of the WebAuthn ceremonies. The server sends options with
+// binary fields as base64url strings, and expects the same encoding back.
+
+function bufferFromBase64url(value) {
+    var base64 = value.replace(/-/g, "+").replace(/_/g, "/");
+    while (base64.length % 4) {
+        base64 += "=";
+    }
+    var binary = atob(base64);
+    var bytes = new Uint8Array(binary.length);
+    for (var i = 0; i < binary.length; i++) {
+        bytes[i] = binary.charCodeAt(i);
+    }
+    return bytes.buffer;
+}
+
+function base64urlFromBuffer(buffer) {
+    var bytes = new Uint8Array(buffer);
+    var binary = "";
+    for (var i = 0; i < bytes.length; i++) {
+        binary += String.fromCharCode(bytes[i]);
+    }
+    return btoa(binary).replace(/\+/g, "-").replace(/\//g, "_").replace(/=+$/, "");
+}
+
+function postJSON(url, csrfToken, body) {
+    return fetch(url, {
+        method: "POST",
+        credentials: "same-origin",
+        headers: {"Content-Type": "application/json", "X-CSRF-Token": csrfToken},
+        body: body ? JSON.stringify(body) : null
+    }).then(function (response) {
+        if (!response.ok) {
+            throw new Error(response.statusText);
+        }
+        return response;
+    });
+}
+
+function registerPasskey(button) {
+    var csrfToken = button.dataset.csrf;
+    var name = document.getElementById("passkey-name").value;
+
+    postJSON("/user/passkeys/register/begin", csrfToken).then(function (response) {
+        return response.json();
+    }).then(function (options) {
+        var publicKey = options.publicKey;
+        publicKey.challenge = bufferFromBase64url(publicKey.challenge);
+        publicKey.user.id = bufferFromBase64url(publicKey.user.id);
+        (publicKey.excludeCredentials || []).forEach(function (cred) {
+            cred.id = bufferFromBase64url(cred.id);
+        });
+        return navigator.credentials.create({publicKey: publicKey});
+    }).then(function (cred) {
+        return postJSON("/user/passkeys/register/finish?name=" + encodeURIComponent(name), csrfToken, {
+            id: cred.id,
+            rawId: base64urlFromBuffer(cred.rawId),
+            type: cred.type,
+            response: {
+                attestationObject: base64urlFromBuffer(cred.response.attestationObject),
+                clientDataJSON: base64urlFromBuffer(cred.response.clientDataJSON)
+            }
+        });
+    }).then(function () {
+        window.location.reload();
+    }).catch(function (err) {
+        alert(button.dataset.error + " " + err.message);
+    });
+}
+
+function loginWithPasskey(button) {
+    var csrfToken = button.dataset.csrf;
+
+    postJSON("/user/login/passkey/begin", csrfToken).then(function (response) {
+        return response.json();
+    }).then(function (options) {
+        var publicKey = options.publicKey;
+        publicKey.challenge = bufferFromBase64url(publicKey.challenge);
+        return navigator.credentials.get({publicKey: publicKey});
+    }).then(function (cred) {
+        return postJSON("/user/login/passkey/finish", csrfToken, {
+            id: cred.id,
+            rawId: base64urlFromBuffer(cred.rawId),
+            type: cred.type,
+            response: {
+                authenticatorData: base64urlFromBuffer(cred.response.authenticatorData),
+                clientDataJSON: base64urlFromBuffer(cred.response.clientDataJSON),
+                signature: base64urlFromBuffer(cred.response.signature),
+                userHandle: cred.response.userHandle ? base64urlFromBuffer(cred.response.userHandle) : null
+            }
+        });
+    }).then(function (response) {
+        // The server redirects once the user is logged in; follow it.
+        window.location = response.url;
+    }).catch(function (err) {
+        alert(button.dataset.error + " " + err.message);
+    });
+}
+
+document.addEventListener("DOMContentLoaded", function () {
+    var register = document.getElementById("passkey-register");
+    if (register) {
+        register.addEventListener("click", function () { registerPasskey(register); });
+    }
+
+    var login = document.getElementById("passkey-login");
+    if (login) {
+        login.addEventListener("click", function () { loginWithPasskey(login); });
+    }
+});
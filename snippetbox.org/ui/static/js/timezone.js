@@ -0,0 +1,19 @@
+// Report the browser's timezone, so dates can be shown in it. The page says
+// which zone the server last heard about, so this only posts when it changes.
+(function () {
+    var script = document.currentScript;
+    var zone = Intl.DateTimeFormat().resolvedOptions().timeZone;
+    if (!zone || zone === script.dataset.zone) {
+        return;
+    }
+
+    fetch("/user/timezone", {
+        method: "POST",
+        credentials: "same-origin",
+        headers: {
+            "Content-Type": "application/x-www-form-urlencoded",
+            "X-CSRF-Token": script.dataset.csrf
+        },
+        body: "timezone=" + encodeURIComponent(zone)
+    });
+})();